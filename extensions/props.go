@@ -24,12 +24,22 @@ const (
 	CheckIdProp = "Check_Id"
 	// CheckDescriptionProp represents the property name for Check descriptions.
 	CheckDescriptionProp = "Check_Description"
+	// TargetComponentProp represents the property name for the title of the target component
+	// a Check in a validation component applies to. It is grouped with the Rule_Id and
+	// Check_Id properties by the same remarks.
+	TargetComponentProp = "Target_Component"
 	// ParameterIdProp represents the property name for Parameter ids.
 	ParameterIdProp = "Parameter_Id"
 	// ParameterDescriptionProp represents the property name for Parameter descriptions.
 	ParameterDescriptionProp = "Parameter_Description"
-	// ParameterDefaultProp represents the property name for Parameter default selected values.
+	// ParameterDefaultProp represents the property name for the default selected value of a
+	// Parameter. For list parameters, it is the comma-separated default selected values.
 	ParameterDefaultProp = "Parameter_Value_Default"
+	// ParameterAlternativesProp represents the property name for the comma-separated allowed
+	// values of a Parameter.
+	ParameterAlternativesProp = "Parameter_Value_Alternatives"
+	// ParameterTypeProp represents the property name for the ParameterType of a Parameter.
+	ParameterTypeProp = "Parameter_Type"
 	// FrameworkProp represents the property name for the control source short name.
 	FrameworkProp = "Framework_Short_Name"
 	// TestParameterClass represents the property class for all test parameters
@@ -41,6 +51,29 @@ const (
 	// AssessmentCheckIdProp represents the property name for a check associated to an OSCAL
 	// Observation.
 	AssessmentCheckIdProp = "assessment-check-id"
+	// AssessmentResultProp represents the property name for the CheckResult of a check on an
+	// OSCAL Observation subject.
+	AssessmentResultProp = "result"
+	// AssessmentReasonProp represents the property name for the reason of a CheckResult on an
+	// OSCAL Observation subject.
+	AssessmentReasonProp = "result-reason"
+	// AssessmentEvidenceProp represents the property name for a reference to the evidence of
+	// a CheckResult on an OSCAL Observation subject.
+	AssessmentEvidenceProp = "evidence-ref"
+	// AssessmentSubjectProp represents the property name for the subject of an aggregated
+	// CheckResult on an OSCAL Result.
+	AssessmentSubjectProp = "assessment-subject-uuid"
+	// FindingTargetProp represents the property name for the target of the OSCAL Finding
+	// tracked by an OSCAL POA&M item, e.g. a control ID.
+	FindingTargetProp = "finding-target-id"
+	// POAMItemStatusProp represents the property name for the status of an OSCAL POA&M item.
+	POAMItemStatusProp = "poam-item-status"
+	// POAMItemStatusChangeProp represents the property name for a status change of an OSCAL
+	// POA&M item. The value is the new status and the remarks are the time and reason of the change.
+	POAMItemStatusChangeProp = "poam-item-status-change"
+	// POAMItemDeferredUntilProp represents the property name for the RFC 3339 time until which
+	// an OSCAL POA&M item is deferred.
+	POAMItemDeferredUntilProp = "poam-item-deferred-until"
 	// SkippedRulesProperty represents the property name for Skipped Rules.
 	SkippedRulesProperty = "skipped"
 	// WaivedRulesProperty represents the property name for Waived Rules.
@@ -111,9 +144,14 @@ func FindAllProps(props []oscalTypes.Property, opts ...FindOption) []oscalTypes.
 // This function also implicitly checks that the property is a trestle-defined property in the namespace.
 func GetTrestleProp(name string, props []oscalTypes.Property) (oscalTypes.Property, bool) {
 	for _, prop := range props {
-		if prop.Name == name && strings.Contains(prop.Ns, TrestleNameSpace) {
+		if prop.Name == name && isTrestleProp(prop) {
 			return prop, true
 		}
 	}
 	return oscalTypes.Property{}, false
 }
+
+// isTrestleProp returns whether the property is in the Trestle namespace.
+func isTrestleProp(prop oscalTypes.Property) bool {
+	return strings.Contains(prop.Ns, TrestleNameSpace)
+}
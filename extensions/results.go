@@ -0,0 +1,217 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+var (
+	// ErrInvalidCheckResult defines an error returned when a check result is not
+	// one of the defined CheckResult values.
+	ErrInvalidCheckResult = errors.New("invalid check result")
+	// ErrCheckOutcomeNotFound defines an error returned when an OSCAL Observation subject
+	// has no check result.
+	ErrCheckOutcomeNotFound = errors.New("check outcome not found")
+)
+
+// ruleResultRemarksPrefix is the prefix of the remarks grouping the properties of a RuleResult.
+const ruleResultRemarksPrefix = "rule_result_"
+
+// CheckResult defines the result of a check on an assessment subject.
+type CheckResult string
+
+const (
+	// CheckResultPass is the result of a check that passed.
+	CheckResultPass CheckResult = "pass"
+	// CheckResultFail is the result of a check that failed.
+	CheckResultFail CheckResult = "fail"
+	// CheckResultError is the result of a check that could not be completed.
+	CheckResultError CheckResult = "error"
+	// CheckResultNotApplicable is the result of a check that does not apply to the subject.
+	CheckResultNotApplicable CheckResult = "not-applicable"
+)
+
+// ParseCheckResult returns the CheckResult for the value or an error if the value
+// is not a defined CheckResult.
+func ParseCheckResult(value string) (CheckResult, error) {
+	switch result := CheckResult(value); result {
+	case CheckResultPass, CheckResultFail, CheckResultError, CheckResultNotApplicable:
+		return result, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidCheckResult, value)
+	}
+}
+
+// AggregateCheckResults returns the combined result of several checks. A failed check takes
+// precedence over a check that could not be completed, which takes precedence over a passed check.
+// The result is not applicable if no check applies and empty if there are no results.
+func AggregateCheckResults(results ...CheckResult) CheckResult {
+	var aggregate CheckResult
+	for _, result := range results {
+		if checkResultPrecedence(result) > checkResultPrecedence(aggregate) {
+			aggregate = result
+		}
+	}
+	return aggregate
+}
+
+func checkResultPrecedence(result CheckResult) int {
+	switch result {
+	case CheckResultFail:
+		return 4
+	case CheckResultError:
+		return 3
+	case CheckResultPass:
+		return 2
+	case CheckResultNotApplicable:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CheckOutcome defines the outcome of a check on an assessment subject as reported
+// by a policy engine.
+type CheckOutcome struct {
+	// Result is the result of the check.
+	Result CheckResult
+	// Reason optionally explains the result, e.g. the error of a check that could
+	// not be completed.
+	Reason string
+	// EvidenceRefs are optional references to the evidence of the result.
+	EvidenceRefs []string
+}
+
+// SetCheckOutcome replaces the check outcome properties of an OSCAL Observation subject.
+// Other properties are kept.
+func SetCheckOutcome(subject *oscalTypes.SubjectReference, outcome CheckOutcome) error {
+	if _, err := ParseCheckResult(string(outcome.Result)); err != nil {
+		return err
+	}
+	var props []oscalTypes.Property
+	if subject.Props != nil {
+		for _, prop := range *subject.Props {
+			if !isCheckOutcomeProp(prop) {
+				props = append(props, prop)
+			}
+		}
+	}
+	props = append(props, oscalTypes.Property{Name: AssessmentResultProp, Value: string(outcome.Result), Ns: TrestleNameSpace})
+	if outcome.Reason != "" {
+		props = append(props, oscalTypes.Property{Name: AssessmentReasonProp, Value: outcome.Reason, Ns: TrestleNameSpace})
+	}
+	for _, ref := range outcome.EvidenceRefs {
+		props = append(props, oscalTypes.Property{Name: AssessmentEvidenceProp, Value: ref, Ns: TrestleNameSpace})
+	}
+	subject.Props = &props
+	return nil
+}
+
+// GetCheckOutcome returns the check outcome of an OSCAL Observation subject. An error is
+// returned if the subject has no check result or the check result is invalid.
+func GetCheckOutcome(subject oscalTypes.SubjectReference) (CheckOutcome, error) {
+	if subject.Props == nil {
+		return CheckOutcome{}, fmt.Errorf("subject %s: %w", subject.SubjectUuid, ErrCheckOutcomeNotFound)
+	}
+	resultProp, found := GetTrestleProp(AssessmentResultProp, *subject.Props)
+	if !found {
+		return CheckOutcome{}, fmt.Errorf("subject %s: %w", subject.SubjectUuid, ErrCheckOutcomeNotFound)
+	}
+	result, err := ParseCheckResult(resultProp.Value)
+	if err != nil {
+		return CheckOutcome{}, fmt.Errorf("subject %s: %w", subject.SubjectUuid, err)
+	}
+
+	outcome := CheckOutcome{Result: result}
+	if reason, found := GetTrestleProp(AssessmentReasonProp, *subject.Props); found {
+		outcome.Reason = reason.Value
+	}
+	for _, prop := range *subject.Props {
+		if prop.Name == AssessmentEvidenceProp && isTrestleProp(prop) {
+			outcome.EvidenceRefs = append(outcome.EvidenceRefs, prop.Value)
+		}
+	}
+	return outcome, nil
+}
+
+func isCheckOutcomeProp(prop oscalTypes.Property) bool {
+	if !isTrestleProp(prop) {
+		return false
+	}
+	switch prop.Name {
+	case AssessmentResultProp, AssessmentReasonProp, AssessmentEvidenceProp:
+		return true
+	}
+	return false
+}
+
+// RuleResult defines the aggregated CheckResult of the checks of a rule, either on all
+// assessment subjects or on a single subject.
+type RuleResult struct {
+	// RuleID is the identifier of the rule.
+	RuleID string
+	// SubjectUUID is the UUID of the assessment subject. It is empty for the result of
+	// the rule on all subjects.
+	SubjectUUID string
+	// Result is the aggregated result of the checks.
+	Result CheckResult
+}
+
+// RuleResultProps returns the trestle properties for RuleResults on an OSCAL Result.
+// The properties of each RuleResult are grouped by remarks, e.g. rule_result_0.
+func RuleResultProps(results []RuleResult) []oscalTypes.Property {
+	var props []oscalTypes.Property
+	for i, result := range results {
+		remarks := fmt.Sprintf("%s%d", ruleResultRemarksPrefix, i)
+		props = append(props, oscalTypes.Property{Name: AssessmentRuleIdProp, Value: result.RuleID, Ns: TrestleNameSpace, Remarks: remarks})
+		if result.SubjectUUID != "" {
+			props = append(props, oscalTypes.Property{Name: AssessmentSubjectProp, Value: result.SubjectUUID, Ns: TrestleNameSpace, Remarks: remarks})
+		}
+		props = append(props, oscalTypes.Property{Name: AssessmentResultProp, Value: string(result.Result), Ns: TrestleNameSpace, Remarks: remarks})
+	}
+	return props
+}
+
+// FindRuleResults returns the RuleResults from the properties of an OSCAL Result in
+// property order. Groups without a rule ID or result are skipped.
+func FindRuleResults(props []oscalTypes.Property) []RuleResult {
+	var order []string
+	byRemarks := make(map[string]*RuleResult)
+	for _, prop := range FindAllProps(props) {
+		if !strings.HasPrefix(prop.Remarks, ruleResultRemarksPrefix) {
+			continue
+		}
+		result, ok := byRemarks[prop.Remarks]
+		if !ok {
+			result = &RuleResult{}
+			byRemarks[prop.Remarks] = result
+			order = append(order, prop.Remarks)
+		}
+		switch prop.Name {
+		case AssessmentRuleIdProp:
+			result.RuleID = prop.Value
+		case AssessmentSubjectProp:
+			result.SubjectUUID = prop.Value
+		case AssessmentResultProp:
+			result.Result = CheckResult(prop.Value)
+		}
+	}
+
+	var results []RuleResult
+	for _, remarks := range order {
+		result := byRemarks[remarks]
+		if result.RuleID == "" || result.Result == "" {
+			continue
+		}
+		results = append(results, *result)
+	}
+	return results
+}
@@ -0,0 +1,118 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCheckResult(t *testing.T) {
+	for _, value := range []string{"pass", "fail", "error", "not-applicable"} {
+		result, err := ParseCheckResult(value)
+		require.NoError(t, err)
+		require.Equal(t, CheckResult(value), result)
+	}
+	_, err := ParseCheckResult("skipped")
+	require.ErrorIs(t, err, ErrInvalidCheckResult)
+}
+
+func TestAggregateCheckResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []CheckResult
+		want    CheckResult
+	}{
+		{
+			name: "Valid/NoResults",
+			want: "",
+		},
+		{
+			name:    "Valid/FailOverError",
+			results: []CheckResult{CheckResultPass, CheckResultError, CheckResultFail},
+			want:    CheckResultFail,
+		},
+		{
+			name:    "Valid/ErrorOverPass",
+			results: []CheckResult{CheckResultPass, CheckResultError, CheckResultNotApplicable},
+			want:    CheckResultError,
+		},
+		{
+			name:    "Valid/PassOverNotApplicable",
+			results: []CheckResult{CheckResultNotApplicable, CheckResultPass, ""},
+			want:    CheckResultPass,
+		},
+		{
+			name:    "Valid/NotApplicable",
+			results: []CheckResult{CheckResultNotApplicable, ""},
+			want:    CheckResultNotApplicable,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, AggregateCheckResults(c.results...))
+		})
+	}
+}
+
+func TestCheckOutcome(t *testing.T) {
+	subject := oscalTypes.SubjectReference{
+		SubjectUuid: "subject",
+		Props: &[]oscalTypes.Property{
+			{Name: "label", Value: "kept"},
+			{Name: AssessmentResultProp, Value: "pass", Ns: TrestleNameSpace},
+			{Name: AssessmentEvidenceProp, Value: "https://stale.example.com", Ns: TrestleNameSpace},
+		},
+	}
+	want := CheckOutcome{
+		Result:       CheckResultError,
+		Reason:       "timeout",
+		EvidenceRefs: []string{"https://evidence.example.com/1", "https://evidence.example.com/2"},
+	}
+	require.NoError(t, SetCheckOutcome(&subject, want))
+	require.Len(t, *subject.Props, 5)
+	require.Equal(t, oscalTypes.Property{Name: "label", Value: "kept"}, (*subject.Props)[0])
+
+	got, err := GetCheckOutcome(subject)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// Evidence references in other namespaces are not part of the outcome.
+	*subject.Props = append(*subject.Props, oscalTypes.Property{Name: AssessmentEvidenceProp, Value: "https://other.example.com", Ns: "https://example.com/ns"})
+	got, err = GetCheckOutcome(subject)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	err = SetCheckOutcome(&subject, CheckOutcome{Result: "skipped"})
+	require.ErrorIs(t, err, ErrInvalidCheckResult)
+
+	_, err = GetCheckOutcome(oscalTypes.SubjectReference{SubjectUuid: "subject"})
+	require.ErrorIs(t, err, ErrCheckOutcomeNotFound)
+	_, err = GetCheckOutcome(oscalTypes.SubjectReference{
+		SubjectUuid: "subject",
+		Props:       &[]oscalTypes.Property{{Name: AssessmentResultProp, Value: "skipped", Ns: TrestleNameSpace}},
+	})
+	require.ErrorIs(t, err, ErrInvalidCheckResult)
+}
+
+func TestRuleResultProps(t *testing.T) {
+	results := []RuleResult{
+		{RuleID: "rule-1", Result: CheckResultFail},
+		{RuleID: "rule-1", SubjectUUID: "subject-1", Result: CheckResultPass},
+		{RuleID: "rule-1", SubjectUUID: "subject-2", Result: CheckResultFail},
+	}
+	props := RuleResultProps(results)
+	require.Equal(t, []oscalTypes.Property{
+		{Name: AssessmentRuleIdProp, Value: "rule-1", Ns: TrestleNameSpace, Remarks: "rule_result_0"},
+		{Name: AssessmentResultProp, Value: "fail", Ns: TrestleNameSpace, Remarks: "rule_result_0"},
+	}, props[:2])
+
+	props = append(props, oscalTypes.Property{Name: "label", Value: "ignored"})
+	require.Equal(t, results, FindRuleResults(props))
+	require.Empty(t, FindRuleResults(nil))
+}
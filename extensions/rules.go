@@ -5,6 +5,28 @@
 
 package extensions
 
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidParameterValue defines an error returned when a selected parameter value
+// is not one of the parameter alternatives or does not match the parameter type.
+var ErrInvalidParameterValue = errors.New("invalid parameter value")
+
+// ValidationComponentType is the OSCAL component type of components that implement
+// the checks of rules.
+const ValidationComponentType = "validation"
+
+// ParameterSuffixRegex matches parameter property names with a numerical suffix that
+// groups the properties of one of multiple rule parameters, e.g. Parameter_Id_1.
+var ParameterSuffixRegex = regexp.MustCompile(`^Parameter_.*\d+$`)
+
 // RuleSet defines a Rule instance with associated
 // Check implementation data.
 type RuleSet struct {
@@ -31,6 +53,14 @@ type Check struct {
 	ID string
 	// Description defines description of what the check does.
 	Description string
+	// TargetComponent is the title of the target component the check applies to.
+	// If empty, the check applies to all components with the associated rule.
+	TargetComponent string
+}
+
+// AppliesTo returns whether the check applies to the target component with the given title.
+func (c Check) AppliesTo(componentTitle string) bool {
+	return c.TargetComponent == "" || c.TargetComponent == componentTitle
 }
 
 // Parameter identifies a parameter or variable that can be used to alter rule logic.
@@ -39,6 +69,100 @@ type Parameter struct {
 	ID string
 	// Description defines description of what the parameter does.
 	Description string
-	// Value is the selected value or option for the parameter.
+	// Value is the selected value or option for the parameter. For multi-valued
+	// parameters, it is the comma-separated list of the selected values.
 	Value string
+	// Values are the selected values of a multi-valued parameter. It is empty for
+	// parameters with a single value.
+	Values []string
+	// Alternatives are the allowed values for the parameter. If empty, any
+	// value of the parameter type is allowed.
+	Alternatives []string
+	// Type is the optional type of the parameter value.
+	Type ParameterType
+}
+
+// SetValues sets the selected values of the parameter. A single value is stored in Value only.
+func (p *Parameter) SetValues(values ...string) {
+	p.Values = nil
+	if len(values) > 1 {
+		p.Values = slices.Clone(values)
+	}
+	p.Value = strings.Join(values, ",")
+}
+
+// SelectedValues returns the selected values of the parameter. It is empty if no
+// value is selected.
+func (p Parameter) SelectedValues() []string {
+	if len(p.Values) > 0 {
+		return p.Values
+	}
+	if p.Value == "" {
+		return nil
+	}
+	return []string{p.Value}
+}
+
+// ParameterType defines the type of parameter values.
+type ParameterType string
+
+const (
+	// ParameterTypeString is a parameter with a string value. Parameters without a type are strings.
+	ParameterTypeString ParameterType = "string"
+	// ParameterTypeInteger is a parameter with an integer value.
+	ParameterTypeInteger ParameterType = "integer"
+	// ParameterTypeBoolean is a parameter with a boolean value, e.g. "true" or "false".
+	ParameterTypeBoolean ParameterType = "boolean"
+	// ParameterTypeDuration is a parameter with a duration value, e.g. "90s" or "24h".
+	ParameterTypeDuration ParameterType = "duration"
+	// ParameterTypeList is a parameter with a comma-separated list value.
+	ParameterTypeList ParameterType = "list"
+)
+
+// ValidateValue returns an error if the value is not one of the parameter alternatives
+// or does not match the parameter type. The items of list values are checked against
+// the alternatives individually.
+func (p Parameter) ValidateValue(value string) error {
+	values := []string{value}
+	switch p.Type {
+	case "", ParameterTypeString:
+	case ParameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%w: parameter %q: %q is not an integer", ErrInvalidParameterValue, p.ID, value)
+		}
+	case ParameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%w: parameter %q: %q is not a boolean", ErrInvalidParameterValue, p.ID, value)
+		}
+	case ParameterTypeDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("%w: parameter %q: %q is not a duration", ErrInvalidParameterValue, p.ID, value)
+		}
+	case ParameterTypeList:
+		values = SplitList(value)
+	default:
+		return fmt.Errorf("%w: parameter %q: unsupported type %q", ErrInvalidParameterValue, p.ID, p.Type)
+	}
+
+	if len(p.Alternatives) == 0 {
+		return nil
+	}
+	for _, v := range values {
+		if !slices.Contains(p.Alternatives, v) {
+			return fmt.Errorf("%w: parameter %q: %q is not one of %s", ErrInvalidParameterValue, p.ID, v, strings.Join(p.Alternatives, ", "))
+		}
+	}
+	return nil
+}
+
+// SplitList returns the items of a comma-separated list property value without
+// surrounding whitespace. Empty items are dropped.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
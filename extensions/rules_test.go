@@ -0,0 +1,186 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_AppliesTo(t *testing.T) {
+	tests := []struct {
+		name           string
+		check          Check
+		componentTitle string
+		want           bool
+	}{
+		{
+			name:           "Valid/NoTargetComponent",
+			check:          Check{ID: "check"},
+			componentTitle: "Kubernetes",
+			want:           true,
+		},
+		{
+			name:           "Valid/MatchingTargetComponent",
+			check:          Check{ID: "check", TargetComponent: "Kubernetes"},
+			componentTitle: "Kubernetes",
+			want:           true,
+		},
+		{
+			name:           "Valid/OtherTargetComponent",
+			check:          Check{ID: "check", TargetComponent: "OpenShift"},
+			componentTitle: "Kubernetes",
+			want:           false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, c.check.AppliesTo(c.componentTitle))
+		})
+	}
+}
+
+func TestParameter_ValidateValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter Parameter
+		value     string
+		expError  string
+	}{
+		{
+			name:      "Valid/Untyped",
+			parameter: Parameter{ID: "file_name"},
+			value:     "/etc/kubernetes/pki",
+		},
+		{
+			name:      "Valid/Alternative",
+			parameter: Parameter{ID: "cipher", Alternatives: []string{"aes256", "aes128"}},
+			value:     "aes128",
+		},
+		{
+			name:      "Valid/Integer",
+			parameter: Parameter{ID: "retries", Type: ParameterTypeInteger},
+			value:     "-3",
+		},
+		{
+			name:      "Valid/Boolean",
+			parameter: Parameter{ID: "enabled", Type: ParameterTypeBoolean},
+			value:     "true",
+		},
+		{
+			name:      "Valid/Duration",
+			parameter: Parameter{ID: "timeout", Type: ParameterTypeDuration},
+			value:     "90s",
+		},
+		{
+			name:      "Valid/ListAlternatives",
+			parameter: Parameter{ID: "registries", Type: ParameterTypeList, Alternatives: []string{"quay.io", "ghcr.io"}},
+			value:     "quay.io, ghcr.io",
+		},
+		{
+			name:      "Invalid/NotAnAlternative",
+			parameter: Parameter{ID: "cipher", Alternatives: []string{"aes256", "aes128"}},
+			value:     "aes512",
+			expError:  `invalid parameter value: parameter "cipher": "aes512" is not one of aes256, aes128`,
+		},
+		{
+			name:      "Invalid/Integer",
+			parameter: Parameter{ID: "retries", Type: ParameterTypeInteger},
+			value:     "three",
+			expError:  `invalid parameter value: parameter "retries": "three" is not an integer`,
+		},
+		{
+			name:      "Invalid/Boolean",
+			parameter: Parameter{ID: "enabled", Type: ParameterTypeBoolean},
+			value:     "yes",
+			expError:  `invalid parameter value: parameter "enabled": "yes" is not a boolean`,
+		},
+		{
+			name:      "Invalid/Duration",
+			parameter: Parameter{ID: "timeout", Type: ParameterTypeDuration},
+			value:     "90",
+			expError:  `invalid parameter value: parameter "timeout": "90" is not a duration`,
+		},
+		{
+			name:      "Invalid/ListAlternatives",
+			parameter: Parameter{ID: "registries", Type: ParameterTypeList, Alternatives: []string{"quay.io", "ghcr.io"}},
+			value:     "quay.io,docker.io",
+			expError:  `invalid parameter value: parameter "registries": "docker.io" is not one of quay.io, ghcr.io`,
+		},
+		{
+			name:      "Invalid/UnsupportedType",
+			parameter: Parameter{ID: "ratio", Type: "float"},
+			value:     "0.5",
+			expError:  `invalid parameter value: parameter "ratio": unsupported type "float"`,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.parameter.ValidateValue(c.value)
+			if c.expError != "" {
+				require.EqualError(t, err, c.expError)
+				require.ErrorIs(t, err, ErrInvalidParameterValue)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	require.Equal(t, []string{"a", "b c", "d"}, SplitList(" a,b c , ,d"))
+	require.Nil(t, SplitList(""))
+}
+
+func TestParameter_SetValues(t *testing.T) {
+	tests := []struct {
+		name               string
+		values             []string
+		wantValue          string
+		wantValues         []string
+		wantSelectedValues []string
+	}{
+		{
+			name: "Valid/NoValues",
+		},
+		{
+			name:               "Valid/SingleValue",
+			values:             []string{"quay.io"},
+			wantValue:          "quay.io",
+			wantSelectedValues: []string{"quay.io"},
+		},
+		{
+			name:               "Valid/MultipleValues",
+			values:             []string{"quay.io", "ghcr.io"},
+			wantValue:          "quay.io,ghcr.io",
+			wantValues:         []string{"quay.io", "ghcr.io"},
+			wantSelectedValues: []string{"quay.io", "ghcr.io"},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			parameter := Parameter{ID: "registries", Value: "default", Values: []string{"a", "b"}}
+			parameter.SetValues(c.values...)
+			require.Equal(t, c.wantValue, parameter.Value)
+			require.Equal(t, c.wantValues, parameter.Values)
+			require.Equal(t, c.wantSelectedValues, parameter.SelectedValues())
+		})
+	}
+}
+
+func TestParameterSuffixRegex(t *testing.T) {
+	tests := map[string]bool{
+		"Parameter_Id":              false,
+		"Parameter_Id_1":            true,
+		"Parameter_Value_Default_2": true,
+		"Parameter_Id2":             true,
+		"Rule_Id_1":                 false,
+	}
+	for name, want := range tests {
+		require.Equal(t, want, ParameterSuffixRegex.MatchString(name), name)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package identifiers generates UUIDs for OSCAL objects created by the SDK.
+package identifiers
+
+import (
+	"strings"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+)
+
+// Generator generates UUIDs for OSCAL objects. The zero value generates
+// random (version 4) UUIDs.
+type Generator struct {
+	deterministic bool
+	seed          string
+}
+
+// NewDeterministic returns a Generator that derives name-based (version 5) UUIDs
+// from the given seed and the keys passed to UUID. The seed distinguishes documents
+// generated from the same inputs and may be empty.
+func NewDeterministic(seed string) Generator {
+	return Generator{deterministic: true, seed: seed}
+}
+
+// Deterministic reports whether the Generator derives UUIDs from stable keys.
+func (g Generator) Deterministic() bool {
+	return g.deterministic
+}
+
+// UUID returns a new UUID. Deterministic generators return the same UUID for the
+// same keys, so the keys must uniquely identify the object within the document.
+func (g Generator) UUID(keys ...string) string {
+	if !g.deterministic {
+		return uuid.NewUUID()
+	}
+	// Keys are joined with a separator that cannot appear in OSCAL tokens
+	// to avoid collisions such as ("ab", "c") and ("a", "bc").
+	return uuid.NewUUIDWithSource(strings.Join(append([]string{g.seed}, keys...), "\x00"))
+}
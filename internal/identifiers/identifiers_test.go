@@ -0,0 +1,59 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	tests := []struct {
+		name        string
+		generator   Generator
+		keys        [][]string
+		wantVersion byte
+		wantEqual   bool
+	}{
+		{
+			name:        "Random",
+			generator:   Generator{},
+			keys:        [][]string{{"comp", "rule"}, {"comp", "rule"}},
+			wantVersion: '4',
+			wantEqual:   false,
+		},
+		{
+			name:        "Deterministic/SameKeys",
+			generator:   NewDeterministic("seed"),
+			keys:        [][]string{{"comp", "rule"}, {"comp", "rule"}},
+			wantVersion: '5',
+			wantEqual:   true,
+		},
+		{
+			name:        "Deterministic/DifferentKeys",
+			generator:   NewDeterministic("seed"),
+			keys:        [][]string{{"comp", "rule"}, {"com", "prule"}},
+			wantVersion: '5',
+			wantEqual:   false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			first := c.generator.UUID(c.keys[0]...)
+			second := c.generator.UUID(c.keys[1]...)
+			for _, id := range []string{first, second} {
+				require.Len(t, id, 36)
+				// The version is the first digit of the third group.
+				require.Equal(t, c.wantVersion, id[14])
+			}
+			require.Equal(t, c.wantEqual, first == second)
+		})
+	}
+
+	// The seed distinguishes documents generated from the same inputs.
+	require.NotEqual(t, NewDeterministic("a").UUID("key"), NewDeterministic("b").UUID("key"))
+}
@@ -0,0 +1,633 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package oscalxml
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The go-oscal types only carry JSON and YAML tags. The tables below hold the parts
+// of the OSCAL Metaschema XML bindings that cannot be derived from the Go types.
+
+// elementScalars are the scalar JSON properties that are XML elements (fields) instead
+// of XML attributes (flags).
+var elementScalars = map[string]bool{
+	"title":                              true,
+	"description":                        true,
+	"remarks":                            true,
+	"published":                          true,
+	"last-modified":                      true,
+	"version":                            true,
+	"oscal-version":                      true,
+	"short-name":                         true,
+	"text":                               true,
+	"label":                              true,
+	"usage":                              true,
+	"prose":                              true,
+	"city":                               true,
+	"postal-code":                        true,
+	"country":                            true,
+	"purpose":                            true,
+	"date-authorized":                    true,
+	"system-name":                        true,
+	"system-name-short":                  true,
+	"security-sensitivity-level":         true,
+	"base":                               true,
+	"selected":                           true,
+	"adjustment-justification":           true,
+	"security-objective-confidentiality": true,
+	"security-objective-integrity":       true,
+	"security-objective-availability":    true,
+	"start":                              true,
+	"end":                                true,
+	"collected":                          true,
+	"expires":                            true,
+	"deadline":                           true,
+	"status-change":                      true,
+	"implementation-statement-uuid":      true,
+	"as-is":                              true,
+	"statement":                          true,
+	"caption":                            true,
+}
+
+// typeElements are scalar properties that are XML elements only in the given type.
+var typeElements = map[string]map[string]bool{
+	"Party":                  {"name": true},
+	"Address":                {"state": true},
+	"LeveragedAuthorization": {"party-uuid": true},
+	"Risk":                   {"status": true},
+	"ObjectiveStatus":        {"state": true, "reason": true},
+	"ConstraintTest":         {"expression": true},
+}
+
+// typeFlags are scalar properties that are XML attributes in the given type although
+// they are XML elements elsewhere.
+var typeFlags = map[string]map[string]bool{
+	"OnDateRangeCondition": {"start": true, "end": true},
+	"PortRange":            {"start": true, "end": true},
+}
+
+// valueKeys maps the types of OSCAL fields with flags to the JSON property that holds
+// the XML element value.
+var valueKeys = map[string]string{
+	"Hash":                    "value",
+	"Base64":                  "value",
+	"DocumentId":              "identifier",
+	"TelephoneNumber":         "number",
+	"SystemId":                "id",
+	"PartyExternalIdentifier": "id",
+	"ThreatId":                "id",
+}
+
+// groupedArrays are the JSON arrays that are wrapped in an XML grouping element.
+var groupedArrays = map[string]bool{
+	"revisions": true,
+}
+
+// arrayElements maps JSON array names (the metaschema group-as names) to the XML
+// element names of their items.
+var arrayElements = map[string]string{
+	"actions":                      "action",
+	"activities":                   "activity",
+	"actors":                       "actor",
+	"adds":                         "add",
+	"addr-lines":                   "addr-line",
+	"addresses":                    "address",
+	"alters":                       "alter",
+	"assessment-platforms":         "assessment-platform",
+	"assessment-subjects":          "assessment-subject",
+	"associated-activities":        "associated-activity",
+	"attestations":                 "attestation",
+	"authorized-privileges":        "authorized-privilege",
+	"by-components":                "by-component",
+	"capabilities":                 "capability",
+	"categorizations":              "categorization",
+	"characterizations":            "characterization",
+	"choice":                       "choice",
+	"components":                   "component",
+	"constraints":                  "constraint",
+	"control-implementations":      "control-implementation",
+	"control-objective-selections": "control-objective-selection",
+	"control-selections":           "control-selection",
+	"controls":                     "control",
+	"dependencies":                 "dependency",
+	"diagrams":                     "diagram",
+	"document-ids":                 "document-id",
+	"email-addresses":              "email-address",
+	"entries":                      "entry",
+	"exclude-controls":             "exclude-control",
+	"exclude-objectives":           "exclude-objective",
+	"exclude-subjects":             "exclude-subject",
+	"external-ids":                 "external-id",
+	"facets":                       "facet",
+	"findings":                     "finding",
+	"functions-performed":          "function-performed",
+	"groups":                       "group",
+	"guidelines":                   "guideline",
+	"hashes":                       "hash",
+	"implemented-components":       "implemented-component",
+	"implemented-requirements":     "implemented-requirement",
+	"import-component-definitions": "import-component-definition",
+	"imports":                      "import",
+	"include-controls":             "include-control",
+	"include-objectives":           "include-objective",
+	"include-subjects":             "include-subject",
+	"incorporates-components":      "incorporates-component",
+	"information-type-ids":         "information-type-id",
+	"information-types":            "information-type",
+	"inherited":                    "inherited",
+	"insert-controls":              "insert-controls",
+	"inventory-items":              "inventory-item",
+	"leveraged-authorizations":     "leveraged-authorization",
+	"links":                        "link",
+	"location-uuids":               "location-uuid",
+	"locations":                    "location",
+	"logged-by":                    "logged-by",
+	"matching":                     "matching",
+	"member-of-organizations":      "member-of-organization",
+	"methods":                      "method",
+	"mitigating-factors":           "mitigating-factor",
+	"objectives-and-methods":       "objectives-and-methods",
+	"observations":                 "observation",
+	"origins":                      "origin",
+	"params":                       "param",
+	"parties":                      "party",
+	"parts":                        "part",
+	"party-uuids":                  "party-uuid",
+	"poam-items":                   "poam-item",
+	"port-ranges":                  "port-range",
+	"props":                        "prop",
+	"protocols":                    "protocol",
+	"provided":                     "provided",
+	"related-findings":             "related-finding",
+	"related-observations":         "related-observation",
+	"related-responses":            "related-response",
+	"related-risks":                "associated-risk",
+	"related-tasks":                "related-task",
+	"relevant-evidence":            "relevant-evidence",
+	"remediations":                 "response",
+	"removes":                      "remove",
+	"required-assets":              "required-asset",
+	"resources":                    "resource",
+	"responsibilities":             "responsibility",
+	"responsible-parties":          "responsible-party",
+	"responsible-roles":            "responsible-role",
+	"results":                      "result",
+	"revisions":                    "revision",
+	"risks":                        "risk",
+	"rlinks":                       "rlink",
+	"role-ids":                     "role-id",
+	"roles":                        "role",
+	"satisfied":                    "satisfied",
+	"set-parameters":               "set-parameter",
+	"statement-ids":                "statement-id",
+	"statements":                   "statement",
+	"steps":                        "step",
+	"subjects":                     "subject",
+	"system-ids":                   "system-id",
+	"tasks":                        "task",
+	"telephone-numbers":            "telephone-number",
+	"tests":                        "test",
+	"threat-ids":                   "threat-id",
+	"types":                        "type",
+	"urls":                         "url",
+	"users":                        "user",
+	"uses-components":              "uses-component",
+	"values":                       "value",
+	"with-ids":                     "with-id",
+}
+
+// typeArrayElements are the XML element names of array items that differ from
+// arrayElements in the given type.
+var typeArrayElements = map[string]map[string]string{
+	"Import":         {"include-controls": "include-controls", "exclude-controls": "exclude-controls"},
+	"InsertControls": {"include-controls": "include-controls", "exclude-controls": "exclude-controls"},
+}
+
+// multilineMarkup are the markup-multiline properties.
+var multilineMarkup = map[string]bool{
+	"description":              true,
+	"remarks":                  true,
+	"prose":                    true,
+	"usage":                    true,
+	"statement":                true,
+	"adjustment-justification": true,
+}
+
+// lineMarkup are the markup-line properties.
+var lineMarkup = map[string]bool{
+	"title":   true,
+	"label":   true,
+	"text":    true,
+	"choice":  true,
+	"purpose": true,
+}
+
+// typeOrder is the XML element order of the model of each type with more than one
+// element, following the sequence of the assembly in the OSCAL Metaschema. Flags are
+// not part of the order.
+var typeOrder = map[string][]string{
+	// Metadata and back-matter
+	"Metadata": {
+		"title", "published", "last-modified", "version", "oscal-version", "revisions", "document-ids",
+		"props", "links", "roles", "locations", "parties", "responsible-parties", "actions", "remarks",
+	},
+	"RevisionHistoryEntry": {"title", "published", "last-modified", "version", "oscal-version", "props", "links", "remarks"},
+	"Role":                 {"title", "short-name", "description", "props", "links", "remarks"},
+	"Location":             {"title", "address", "email-addresses", "telephone-numbers", "urls", "props", "links", "remarks"},
+	"Address":              {"addr-lines", "city", "state", "postal-code", "country"},
+	"Party": {
+		"name", "short-name", "external-ids", "props", "links", "email-addresses", "telephone-numbers",
+		"addresses", "location-uuids", "member-of-organizations", "remarks",
+	},
+	"ResponsibleParty": {"party-uuids", "props", "links", "remarks"},
+	"ResponsibleRole":  {"props", "links", "party-uuids", "remarks"},
+	"Action":           {"props", "links", "responsible-parties", "remarks"},
+	"Citation":         {"text", "props", "links"},
+	"Resource":         {"title", "description", "props", "document-ids", "citation", "rlinks", "base64", "remarks"},
+
+	// Catalog
+	"Catalog":             {"metadata", "params", "controls", "groups", "back-matter"},
+	"Group":               {"title", "params", "props", "links", "parts", "groups", "controls"},
+	"Control":             {"title", "params", "props", "links", "parts", "controls"},
+	"Part":                {"title", "props", "prose", "parts", "links"},
+	"Parameter":           {"props", "links", "label", "usage", "constraints", "guidelines", "values", "select", "remarks"},
+	"ConstraintTest":      {"expression", "remarks"},
+	"ParameterConstraint": {"description", "tests"},
+
+	// Profile
+	"Profile":             {"metadata", "imports", "merge", "modify", "back-matter"},
+	"Import":              {"include-all", "include-controls", "exclude-controls"},
+	"SelectControlById":   {"with-ids", "matching"},
+	"Merge":               {"combine", "flat", "as-is", "custom"},
+	"CustomGrouping":      {"groups", "insert-controls"},
+	"CustomGroupingGroup": {"title", "params", "props", "links", "parts", "groups", "insert-controls"},
+	"InsertControls":      {"include-all", "include-controls", "exclude-controls"},
+	"Modify":              {"set-parameters", "alters"},
+	"Alteration":          {"removes", "adds"},
+	"Addition":            {"title", "params", "props", "links", "parts"},
+	"ParameterSetting":    {"props", "links", "label", "usage", "constraints", "guidelines", "values", "select"},
+
+	// Component Definition
+	"ComponentDefinition": {"metadata", "import-component-definitions", "components", "capabilities", "back-matter"},
+	"DefinedComponent": {
+		"title", "description", "purpose", "props", "links", "responsible-roles", "protocols",
+		"control-implementations", "remarks",
+	},
+	"Capability":               {"description", "props", "links", "incorporates-components", "control-implementations", "remarks"},
+	"ControlImplementationSet": {"description", "props", "links", "set-parameters", "implemented-requirements"},
+	"ImplementedRequirementControlImplementation": {
+		"description", "props", "links", "set-parameters", "responsible-roles", "statements", "remarks",
+	},
+	"ControlStatementImplementation": {"description", "props", "links", "responsible-roles", "remarks"},
+	"SetParameter":                   {"values", "remarks"},
+	"Protocol":                       {"title", "port-ranges"},
+
+	// System Security Plan
+	"SystemSecurityPlan": {
+		"metadata", "import-profile", "system-characteristics", "system-implementation",
+		"control-implementation", "back-matter",
+	},
+	"SystemCharacteristics": {
+		"system-ids", "system-name", "system-name-short", "description", "props", "links", "date-authorized",
+		"security-sensitivity-level", "system-information", "security-impact-level", "status",
+		"authorization-boundary", "network-architecture", "data-flow", "responsible-parties", "remarks",
+	},
+	"SystemInformation": {"props", "links", "information-types"},
+	"InformationType": {
+		"title", "description", "categorizations", "props", "links", "confidentiality-impact",
+		"integrity-impact", "availability-impact",
+	},
+	"Impact": {"props", "links", "base", "selected", "adjustment-justification"},
+	"SecurityImpactLevel": {
+		"security-objective-confidentiality", "security-objective-integrity", "security-objective-availability",
+	},
+	"AuthorizationBoundary": {"description", "props", "links", "diagrams", "remarks"},
+	"NetworkArchitecture":   {"description", "props", "links", "diagrams", "remarks"},
+	"DataFlow":              {"description", "props", "links", "diagrams", "remarks"},
+	"Diagram":               {"description", "props", "links", "caption", "remarks"},
+	"SystemImplementation": {
+		"props", "links", "leveraged-authorizations", "users", "components", "inventory-items", "remarks",
+	},
+	"LeveragedAuthorization": {"title", "props", "links", "party-uuid", "date-authorized", "remarks"},
+	"SystemUser": {
+		"title", "short-name", "description", "props", "links", "role-ids", "authorized-privileges", "remarks",
+	},
+	"AuthorizedPrivilege": {"title", "description", "functions-performed"},
+	"SystemComponent": {
+		"title", "description", "purpose", "props", "links", "status", "responsible-roles", "protocols", "remarks",
+	},
+	"InventoryItem":         {"description", "props", "links", "responsible-parties", "implemented-components", "remarks"},
+	"ImplementedComponent":  {"props", "links", "responsible-parties", "remarks"},
+	"ControlImplementation": {"description", "set-parameters", "implemented-requirements"},
+	"ImplementedRequirement": {
+		"props", "links", "set-parameters", "responsible-roles", "statements", "by-components", "remarks",
+	},
+	"Statement": {"props", "links", "responsible-roles", "by-components", "remarks"},
+	"ByComponent": {
+		"description", "props", "links", "set-parameters", "implementation-status", "export", "inherited",
+		"satisfied", "responsible-roles", "remarks",
+	},
+	"Export":                                       {"description", "props", "links", "provided", "responsibilities", "remarks"},
+	"ProvidedControlImplementation":                {"description", "props", "links", "responsible-roles", "remarks"},
+	"ControlImplementationResponsibility":          {"description", "props", "links", "responsible-roles", "remarks"},
+	"InheritedControlImplementation":               {"description", "props", "links", "responsible-roles"},
+	"SatisfiedControlImplementationResponsibility": {"description", "props", "links", "responsible-roles", "remarks"},
+
+	// Assessment Plan and Assessment Results
+	"AssessmentPlan": {
+		"metadata", "import-ssp", "local-definitions", "terms-and-conditions", "reviewed-controls",
+		"assessment-subjects", "assessment-assets", "tasks", "back-matter",
+	},
+	"LocalDefinitions": {"components", "inventory-items", "users", "objectives-and-methods", "activities", "remarks"},
+	"ReviewedControls": {
+		"description", "props", "links", "control-selections", "control-objective-selections", "remarks",
+	},
+	"AssessedControls": {
+		"description", "props", "links", "include-all", "include-controls", "exclude-controls", "remarks",
+	},
+	"ReferencedControlObjectives": {
+		"description", "props", "links", "include-all", "include-objectives", "exclude-objectives", "remarks",
+	},
+	"AssessmentSubject": {
+		"description", "props", "links", "include-all", "include-subjects", "exclude-subjects", "remarks",
+	},
+	"SelectSubjectById":  {"props", "links", "remarks"},
+	"AssessmentAssets":   {"components", "assessment-platforms"},
+	"AssessmentPlatform": {"title", "props", "links", "uses-components", "remarks"},
+	"UsesComponent":      {"props", "links", "responsible-parties", "remarks"},
+	"Task": {
+		"title", "description", "props", "links", "timing", "dependencies", "tasks", "associated-activities",
+		"subjects", "responsible-roles", "remarks",
+	},
+	"EventTiming":        {"on-date", "within-date-range", "at-frequency"},
+	"AssociatedActivity": {"props", "links", "responsible-roles", "subjects", "remarks"},
+	"Activity": {
+		"title", "description", "props", "links", "steps", "related-controls", "responsible-roles", "remarks",
+	},
+	"Step":           {"title", "description", "props", "links", "reviewed-controls", "responsible-roles", "remarks"},
+	"LocalObjective": {"description", "props", "links", "parts", "remarks"},
+	"AssessmentPart": {"title", "props", "prose", "parts", "links"},
+	"AssessmentResults": {
+		"metadata", "import-ap", "local-definitions", "results", "back-matter",
+	},
+	"Result": {
+		"title", "description", "start", "end", "props", "links", "local-definitions", "reviewed-controls",
+		"attestations", "assessment-log", "observations", "risks", "findings", "remarks",
+	},
+	"AttestationStatements": {"responsible-parties", "parts"},
+	"AssessmentLogEntry": {
+		"title", "description", "start", "end", "props", "links", "logged-by", "related-tasks", "remarks",
+	},
+	"RelatedTask": {"props", "links", "responsible-parties", "subjects", "identified-subject", "remarks"},
+	"Observation": {
+		"title", "description", "props", "links", "methods", "types", "origins", "subjects",
+		"relevant-evidence", "collected", "expires", "remarks",
+	},
+	"Origin":           {"actors", "related-tasks"},
+	"OriginActor":      {"props", "links"},
+	"RelevantEvidence": {"description", "props", "links", "remarks"},
+	"SubjectReference": {"title", "props", "links", "remarks"},
+	"Risk": {
+		"title", "description", "statement", "props", "links", "status", "origins", "threat-ids",
+		"characterizations", "mitigating-factors", "deadline", "remediations", "risk-log", "related-observations",
+	},
+	"Characterization": {"props", "links", "origin", "facets"},
+	"Facet":            {"props", "links", "remarks"},
+	"MitigatingFactor": {"description", "props", "links", "subjects"},
+	"Response":         {"title", "description", "props", "links", "origins", "required-assets", "tasks", "remarks"},
+	"RequiredAsset":    {"subjects", "title", "description", "props", "links", "remarks"},
+	"RiskLogEntry": {
+		"title", "description", "start", "end", "props", "links", "logged-by", "status-change",
+		"related-responses", "remarks",
+	},
+	"RiskResponseReference": {"props", "links", "related-tasks", "remarks"},
+	"Finding": {
+		"title", "description", "props", "links", "origins", "target", "implementation-statement-uuid",
+		"related-observations", "related-risks", "remarks",
+	},
+	"FindingTarget":   {"title", "description", "props", "links", "status", "implementation-status", "remarks"},
+	"ObjectiveStatus": {"state", "reason", "remarks"},
+
+	// Plan of Action and Milestones
+	"PlanOfActionAndMilestones": {
+		"metadata", "import-ssp", "system-id", "local-definitions", "observations", "risks", "findings",
+		"poam-items", "back-matter",
+	},
+	"PlanOfActionAndMilestonesLocalDefinitions": {"components", "inventory-items", "assessment-assets", "remarks"},
+	"PoamItem": {
+		"title", "description", "props", "links", "origins", "related-findings", "related-observations",
+		"related-risks", "remarks",
+	},
+}
+
+type fieldKind int
+
+const (
+	kindScalar fieldKind = iota
+	kindStruct
+	kindMap
+	kindScalarSlice
+	kindStructSlice
+)
+
+type markupKind int
+
+const (
+	markupNone markupKind = iota
+	markupLine
+	markupMultiline
+)
+
+// field describes how a struct field of an OSCAL type is bound to XML.
+type field struct {
+	index []int
+	// name is the JSON property name.
+	name string
+	// element is the XML element name. For slices it is the name of each item.
+	element string
+	kind    fieldKind
+	// typ is the scalar type, or the struct type of structs and struct slices.
+	typ     reflect.Type
+	flag    bool
+	grouped bool
+	markup  markupKind
+}
+
+// typeInfo describes the XML binding of an OSCAL type.
+type typeInfo struct {
+	// fields are in XML element order.
+	fields []*field
+	// elements maps XML element names to fields.
+	elements map[string]*field
+	// attributes maps XML attribute names to fields.
+	attributes map[string]*field
+	// value is the field holding the element value of OSCAL fields with flags.
+	value *field
+	// prose is the field holding unwrapped markup.
+	prose *field
+}
+
+var typeInfos sync.Map
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func infoOf(t reflect.Type) *typeInfo {
+	if info, ok := typeInfos.Load(t); ok {
+		return info.(*typeInfo)
+	}
+	info := buildTypeInfo(t)
+	actual, _ := typeInfos.LoadOrStore(t, info)
+	return actual.(*typeInfo)
+}
+
+func buildTypeInfo(t reflect.Type) *typeInfo {
+	info := &typeInfo{
+		elements:   make(map[string]*field),
+		attributes: make(map[string]*field),
+	}
+	valueKey := valueKeys[t.Name()]
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if name == "" || name == "-" || !structField.IsExported() {
+			continue
+		}
+
+		f := &field{index: structField.Index, name: name, element: name}
+		typ := deref(structField.Type)
+		switch {
+		case isScalar(typ):
+			f.kind = kindScalar
+			f.typ = typ
+		case typ.Kind() == reflect.Struct:
+			f.kind = kindStruct
+			f.typ = typ
+		case typ.Kind() == reflect.Map:
+			f.kind = kindMap
+		case typ.Kind() == reflect.Slice:
+			f.typ = deref(typ.Elem())
+			f.kind = kindStructSlice
+			if isScalar(f.typ) {
+				f.kind = kindScalarSlice
+			}
+			f.element = arrayElement(t.Name(), name)
+			f.grouped = groupedArrays[name]
+		default:
+			continue
+		}
+
+		switch {
+		case multilineMarkup[name]:
+			f.markup = markupMultiline
+		case lineMarkup[name]:
+			f.markup = markupLine
+		}
+
+		switch {
+		case name == valueKey:
+			info.value = f
+			continue
+		case f.kind == kindScalar && name == "prose":
+			info.prose = f
+		case f.kind == kindScalar && isFlag(t.Name(), name):
+			f.flag = true
+			info.attributes[name] = f
+		case f.grouped:
+			info.elements[name] = f
+		default:
+			info.elements[f.element] = f
+		}
+		info.fields = append(info.fields, f)
+	}
+
+	order := typeOrder[t.Name()]
+	position := func(name string) int {
+		for i, n := range order {
+			if n == name {
+				return i
+			}
+		}
+		return len(order)
+	}
+	sort.SliceStable(info.fields, func(i, j int) bool {
+		return position(info.fields[i].name) < position(info.fields[j].name)
+	})
+	return info
+}
+
+// PropertyOrder returns the JSON property names of an OSCAL struct type in canonical
+// order. Flags come first, followed by the value of OSCAL fields with flags and the
+// model content in XML element order.
+func PropertyOrder(t reflect.Type) []string {
+	info := infoOf(t)
+	var flags, content []string
+	for _, f := range info.fields {
+		if f.flag {
+			flags = append(flags, f.name)
+		} else {
+			content = append(content, f.name)
+		}
+	}
+	sort.SliceStable(flags, func(i, j int) bool {
+		return flagRank(flags[i]) < flagRank(flags[j])
+	})
+	if info.value != nil {
+		flags = append(flags, info.value.name)
+	}
+	return append(flags, content...)
+}
+
+// flagRank returns the position of identifying flags before all other flags.
+func flagRank(name string) int {
+	switch name {
+	case "uuid", "id":
+		return 0
+	case "name", "param-id", "control-id", "role-id":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func isFlag(typeName, name string) bool {
+	if typeFlags[typeName][name] {
+		return true
+	}
+	return !elementScalars[name] && !typeElements[typeName][name]
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Int, reflect.Bool:
+		return true
+	default:
+		return t == timeType
+	}
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// arrayElement returns the XML element name for the items of a JSON array in the type.
+func arrayElement(typeName, name string) string {
+	if element, ok := typeArrayElements[typeName][name]; ok {
+		return element
+	}
+	if element, ok := arrayElements[name]; ok {
+		return element
+	}
+	return name
+}
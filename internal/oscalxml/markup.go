@@ -0,0 +1,371 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package oscalxml
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// OSCAL markup is stored as Markdown in JSON and YAML and as a subset of HTML in XML.
+// Reference: https://pages.nist.gov/metaschema/specification/datatypes/#markup-data-types
+
+// blockElements are the XML elements of markup-multiline content.
+var blockElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "pre": true, "blockquote": true, "table": true, "hr": true,
+}
+
+var (
+	headingPattern   = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	listItemPattern  = regexp.MustCompile(`^(\s*)([-*+]|\d+\.)\s+(.*)$`)
+	tableSepPattern  = regexp.MustCompile(`^\|?(\s*:?-+:?\s*\|)+\s*:?-*:?\s*\|?$`)
+	insertPattern    = regexp.MustCompile(`^\{\{\s*insert:\s*([^,\s]+)\s*,\s*([^}\s]+)\s*\}\}`)
+	linkPattern      = regexp.MustCompile(`^\[([^\]]*)\]\(([^)\s]*)\)`)
+	imagePattern     = regexp.MustCompile(`^!\[([^\]]*)\]\(([^)\s]*)\)`)
+	horizontalRuleMD = regexp.MustCompile(`^(-{3,}|\*{3,})$`)
+	lineBreakSpace   = regexp.MustCompile(`[ \t\r]*\n\s*`)
+	spaces           = regexp.MustCompile(`[ \t\r]+`)
+)
+
+// markdownBlocks returns the XML block elements for markup-multiline Markdown.
+func markdownBlocks(md string) []string {
+	return parseBlocks(strings.Split(md, "\n"))
+}
+
+func parseBlocks(lines []string) []string {
+	var blocks []string
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case strings.HasPrefix(trimmed, "```"):
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+				code = append(code, lines[i])
+				i++
+			}
+			i++
+			blocks = append(blocks, "<pre>"+escapeString(strings.Join(code, "\n"))+"</pre>")
+		case horizontalRuleMD.MatchString(trimmed):
+			blocks = append(blocks, "<hr/>")
+			i++
+		case headingPattern.MatchString(trimmed):
+			m := headingPattern.FindStringSubmatch(trimmed)
+			tag := fmt.Sprintf("h%d", len(m[1]))
+			blocks = append(blocks, "<"+tag+">"+markdownInline(m[2])+"</"+tag+">")
+			i++
+		case listItemPattern.MatchString(line):
+			var list []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" &&
+				(listItemPattern.MatchString(lines[i]) || indentOf(lines[i]) > 0) {
+				list = append(list, lines[i])
+				i++
+			}
+			blocks = append(blocks, parseList(list))
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				quoted = append(quoted, strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">"), " "))
+				i++
+			}
+			blocks = append(blocks, "<blockquote>"+strings.Join(parseBlocks(quoted), "")+"</blockquote>")
+		case strings.HasPrefix(trimmed, "|") && i+1 < len(lines) && tableSepPattern.MatchString(strings.TrimSpace(lines[i+1])):
+			rows := []string{line}
+			i += 2
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|") {
+				rows = append(rows, lines[i])
+				i++
+			}
+			blocks = append(blocks, parseTable(rows))
+		default:
+			var paragraph []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && (len(paragraph) == 0 || !startsBlock(lines[i])) {
+				paragraph = append(paragraph, lines[i])
+				i++
+			}
+			blocks = append(blocks, "<p>"+markdownInline(strings.Join(paragraph, "\n"))+"</p>")
+		}
+	}
+	return blocks
+}
+
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, ">") ||
+		headingPattern.MatchString(trimmed) || listItemPattern.MatchString(line)
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// parseList returns the XML list for Markdown list lines. Lines indented deeper than
+// the first item belong to the preceding item and may hold nested lists.
+func parseList(lines []string) string {
+	base := indentOf(lines[0])
+	tag := "ul"
+	if m := listItemPattern.FindStringSubmatch(lines[0]); m != nil && strings.HasSuffix(m[2], ".") {
+		tag = "ol"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<" + tag + ">")
+	for i := 0; i < len(lines); {
+		m := listItemPattern.FindStringSubmatch(lines[i])
+		text := strings.TrimSpace(lines[i])
+		if m != nil {
+			text = m[3]
+		}
+		i++
+		var nested []string
+		for i < len(lines) && indentOf(lines[i]) > base {
+			nested = append(nested, lines[i])
+			i++
+		}
+		sb.WriteString("<li>" + markdownInline(text))
+		if len(nested) > 0 {
+			if listItemPattern.MatchString(nested[0]) {
+				sb.WriteString(parseList(nested))
+			} else {
+				sb.WriteString(markdownInline("\n" + strings.Join(nested, "\n")))
+			}
+		}
+		sb.WriteString("</li>")
+	}
+	sb.WriteString("</" + tag + ">")
+	return sb.String()
+}
+
+func parseTable(rows []string) string {
+	var sb strings.Builder
+	sb.WriteString("<table>")
+	for r, row := range rows {
+		cell := "td"
+		if r == 0 {
+			cell = "th"
+		}
+		sb.WriteString("<tr>")
+		for _, value := range tableCells(row) {
+			sb.WriteString("<" + cell + ">" + markdownInline(value) + "</" + cell + ">")
+		}
+		sb.WriteString("</tr>")
+	}
+	sb.WriteString("</table>")
+	return sb.String()
+}
+
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(strings.TrimSuffix(row, "|"), "|")
+	cells := strings.Split(row, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+// markdownInline returns the XML inline markup for markup-line Markdown.
+func markdownInline(md string) string {
+	var sb strings.Builder
+	for i := 0; i < len(md); {
+		rest := md[i:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("\\`*_{}[]()#+-.!|~^", rune(rest[1])):
+			sb.WriteString(escapeString(rest[1:2]))
+			i += 2
+		case insertPattern.MatchString(rest):
+			m := insertPattern.FindStringSubmatch(rest)
+			sb.WriteString(`<insert type="` + escapeString(m[1]) + `" id-ref="` + escapeString(m[2]) + `"/>`)
+			i += len(m[0])
+		case imagePattern.MatchString(rest):
+			m := imagePattern.FindStringSubmatch(rest)
+			sb.WriteString(`<img alt="` + escapeString(m[1]) + `" src="` + escapeString(m[2]) + `"/>`)
+			i += len(m[0])
+		case linkPattern.MatchString(rest):
+			m := linkPattern.FindStringSubmatch(rest)
+			sb.WriteString(`<a href="` + escapeString(m[2]) + `">` + markdownInline(m[1]) + `</a>`)
+			i += len(m[0])
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end > 0 {
+				sb.WriteString("<code>" + escapeString(rest[1:end+1]) + "</code>")
+				i += end + 2
+				continue
+			}
+			sb.WriteString("`")
+			i++
+		case strings.HasPrefix(rest, "**"):
+			if end := strings.Index(rest[2:], "**"); end > 0 {
+				sb.WriteString("<strong>" + markdownInline(rest[2:end+2]) + "</strong>")
+				i += end + 4
+				continue
+			}
+			sb.WriteString("**")
+			i += 2
+		case rest[0] == '*' && len(rest) > 1 && rest[1] != ' ':
+			if end := strings.IndexByte(rest[1:], '*'); end > 0 {
+				sb.WriteString("<em>" + markdownInline(rest[1:end+1]) + "</em>")
+				i += end + 2
+				continue
+			}
+			sb.WriteString("*")
+			i++
+		default:
+			next := strings.IndexAny(rest[1:], "\\{![`*")
+			if next < 0 {
+				next = len(rest) - 1
+			}
+			sb.WriteString(escapeString(rest[:next+1]))
+			i += next + 1
+		}
+	}
+	return sb.String()
+}
+
+func escapeString(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// markupToMarkdown returns the Markdown for XML markup content. Content without
+// block elements is converted as markup-line.
+func markupToMarkdown(content []interface{}) string {
+	hasBlocks := false
+	for _, c := range content {
+		if n, ok := c.(*node); ok && blockElements[n.name] {
+			hasBlocks = true
+			break
+		}
+	}
+	if !hasBlocks {
+		return strings.TrimSpace(inlineMarkdown(content))
+	}
+
+	var blocks []string
+	for _, c := range content {
+		switch c := c.(type) {
+		case string:
+			if text := strings.TrimSpace(c); text != "" {
+				blocks = append(blocks, text)
+			}
+		case *node:
+			blocks = append(blocks, blockMarkdown(c))
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func blockMarkdown(n *node) string {
+	switch n.name {
+	case "p":
+		return strings.TrimSpace(inlineMarkdown(n.content))
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(n.name[1:])
+		return strings.Repeat("#", level) + " " + strings.TrimSpace(inlineMarkdown(n.content))
+	case "ul", "ol":
+		return listMarkdown(n, "")
+	case "pre":
+		return "```\n" + n.text() + "\n```"
+	case "hr":
+		return "---"
+	case "blockquote":
+		lines := strings.Split(markupToMarkdown(n.content), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	case "table":
+		var rows []string
+		for r, tr := range n.children() {
+			var cells []string
+			for _, cell := range tr.children() {
+				cells = append(cells, strings.TrimSpace(inlineMarkdown(cell.content)))
+			}
+			rows = append(rows, "| "+strings.Join(cells, " | ")+" |")
+			if r == 0 {
+				rows = append(rows, "|"+strings.Repeat(" --- |", len(cells)))
+			}
+		}
+		return strings.Join(rows, "\n")
+	default:
+		return strings.TrimSpace(inlineMarkdown(n.content))
+	}
+}
+
+func listMarkdown(list *node, indent string) string {
+	var lines []string
+	number := 0
+	for _, item := range list.children() {
+		if item.name != "li" {
+			continue
+		}
+		number++
+		marker := "- "
+		if list.name == "ol" {
+			marker = strconv.Itoa(number) + ". "
+		}
+		var text []interface{}
+		var nested []string
+		for _, c := range item.content {
+			if child, ok := c.(*node); ok && (child.name == "ul" || child.name == "ol") {
+				nested = append(nested, listMarkdown(child, indent+"  "))
+				continue
+			}
+			text = append(text, c)
+		}
+		lines = append(lines, indent+marker+strings.TrimSpace(inlineMarkdown(text)))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inlineMarkdown returns the Markdown for inline XML markup. Whitespace in the text is
+// collapsed to single spaces and line breaks, so indentation of pretty-printed XML is not kept.
+func inlineMarkdown(content []interface{}) string {
+	var sb strings.Builder
+	for _, c := range content {
+		switch c := c.(type) {
+		case string:
+			sb.WriteString(spaces.ReplaceAllString(lineBreakSpace.ReplaceAllString(c, "\n"), " "))
+		case *node:
+			inner := inlineMarkdown(c.content)
+			switch c.name {
+			case "em", "i":
+				sb.WriteString("*" + inner + "*")
+			case "strong", "b":
+				sb.WriteString("**" + inner + "**")
+			case "code":
+				sb.WriteString("`" + inner + "`")
+			case "q":
+				sb.WriteString(`"` + inner + `"`)
+			case "a":
+				sb.WriteString("[" + inner + "](" + attr(c, "href") + ")")
+			case "img":
+				sb.WriteString("![" + attr(c, "alt") + "](" + attr(c, "src") + ")")
+			case "insert":
+				sb.WriteString("{{ insert: " + attr(c, "type") + ", " + attr(c, "id-ref") + " }}")
+			case "br":
+				sb.WriteString("\n")
+			default:
+				sb.WriteString(inner)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func attr(n *node, name string) string {
+	for _, a := range n.attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package oscalxml
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		wantXML  string
+	}{
+		{
+			name:     "Paragraphs",
+			markdown: "First line\nsecond line.\n\nSecond paragraph.",
+			wantXML:  "<p>First line\nsecond line.</p><p>Second paragraph.</p>",
+		},
+		{
+			name:     "Inline",
+			markdown: "Use **strong**, *em*, `a < b` and [links](https://example.com) with {{ insert: param, ac-1_prm_1 }}.",
+			wantXML:  `<p>Use <strong>strong</strong>, <em>em</em>, <code>a &lt; b</code> and <a href="https://example.com">links</a> with <insert type="param" id-ref="ac-1_prm_1"/>.</p>`,
+		},
+		{
+			name:     "Heading",
+			markdown: "## Heading\n\nText",
+			wantXML:  "<h2>Heading</h2><p>Text</p>",
+		},
+		{
+			name:     "Lists",
+			markdown: "- one\n- two\n  1. nested\n  2. nested two",
+			wantXML:  "<ul><li>one</li><li>two<ol><li>nested</li><li>nested two</li></ol></li></ul>",
+		},
+		{
+			name:     "Code",
+			markdown: "```\nfunc main() {}\n```",
+			wantXML:  "<pre>func main() {}</pre>",
+		},
+		{
+			name:     "Blockquote",
+			markdown: "> quoted",
+			wantXML:  "<blockquote><p>quoted</p></blockquote>",
+		},
+		{
+			name:     "Table",
+			markdown: "| a | b |\n| --- | --- |\n| 1 | 2 |",
+			wantXML:  "<table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2</td></tr></table>",
+		},
+		{
+			name:     "LiteralCharacters",
+			markdown: "5 * 3 & a_b_c {not an insert}",
+			wantXML:  "<p>5 * 3 &amp; a_b_c {not an insert}</p>",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			xmlData := strings.Join(markdownBlocks(c.markdown), "")
+			require.Equal(t, c.wantXML, xmlData)
+
+			root, err := parse(strings.NewReader("<description>" + xmlData + "</description>"))
+			require.NoError(t, err)
+			require.Equal(t, c.markdown, markupToMarkdown(root.content))
+		})
+	}
+}
+
+func TestArrayElement(t *testing.T) {
+	tests := []struct {
+		typeName string
+		name     string
+		want     string
+	}{
+		{typeName: "Control", name: "props", want: "prop"},
+		{typeName: "Metadata", name: "parties", want: "party"},
+		{typeName: "ResourceLink", name: "hashes", want: "hash"},
+		{typeName: "AuthorizedPrivilege", name: "functions-performed", want: "function-performed"},
+		{typeName: "Observation", name: "relevant-evidence", want: "relevant-evidence"},
+		{typeName: "AssessedControls", name: "include-controls", want: "include-control"},
+		{typeName: "Import", name: "include-controls", want: "include-controls"},
+		{typeName: "InsertControls", name: "exclude-controls", want: "exclude-controls"},
+		{typeName: "CustomGrouping", name: "insert-controls", want: "insert-controls"},
+		{typeName: "Risk", name: "remediations", want: "response"},
+		{typeName: "Finding", name: "related-risks", want: "associated-risk"},
+	}
+	for _, c := range tests {
+		require.Equal(t, c.want, arrayElement(c.typeName, c.name), c.typeName+"/"+c.name)
+	}
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
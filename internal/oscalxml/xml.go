@@ -0,0 +1,404 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package oscalxml
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+// Namespace is the XML namespace of OSCAL documents.
+const Namespace = "http://csrc.nist.gov/ns/oscal/1.0"
+
+var (
+	// ErrNoModel is returned when there is no OSCAL model to encode.
+	ErrNoModel = errors.New("no OSCAL model found")
+	// ErrUnknownRoot is returned when the root element of a document is not an OSCAL model.
+	ErrUnknownRoot = errors.New("unknown root element")
+)
+
+// Marshal returns the OSCAL XML encoding of the model set in the OSCAL models.
+func Marshal(models *oscalTypes.OscalModels) ([]byte, error) {
+	root := reflect.ValueOf(models).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		model := root.Field(i)
+		if model.IsNil() {
+			continue
+		}
+		name, _, _ := strings.Cut(root.Type().Field(i).Tag.Get("json"), ",")
+
+		var buf bytes.Buffer
+		buf.WriteString(xml.Header)
+		w := &writer{buf: &buf}
+		w.writeStruct(name, model.Elem(), 0, true)
+		return buf.Bytes(), nil
+	}
+	return nil, ErrNoModel
+}
+
+// Unmarshal decodes the OSCAL XML document in data into the OSCAL models.
+// Like the JSON decoding in the SDK, unknown elements and attributes are rejected.
+func Unmarshal(data []byte, models *oscalTypes.OscalModels) error {
+	root, err := parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	rootType := reflect.TypeOf(models).Elem()
+	for i := 0; i < rootType.NumField(); i++ {
+		name, _, _ := strings.Cut(rootType.Field(i).Tag.Get("json"), ",")
+		if name != root.name {
+			continue
+		}
+		document, err := decodeStruct(root, deref(rootType.Field(i).Type))
+		if err != nil {
+			return err
+		}
+		jsonData, err := json.Marshal(map[string]interface{}{name: document})
+		if err != nil {
+			return err
+		}
+		dec := json.NewDecoder(bytes.NewReader(jsonData))
+		dec.DisallowUnknownFields()
+		return dec.Decode(models)
+	}
+	return fmt.Errorf("%w %q", ErrUnknownRoot, root.name)
+}
+
+// writer writes the indented XML representation of OSCAL types.
+type writer struct {
+	buf *bytes.Buffer
+}
+
+func (w *writer) indent(depth int) {
+	w.buf.WriteString(strings.Repeat("  ", depth))
+}
+
+func (w *writer) writeStruct(name string, v reflect.Value, depth int, root bool) {
+	info := infoOf(v.Type())
+
+	w.indent(depth)
+	w.buf.WriteString("<" + name)
+	if root {
+		w.writeAttr("xmlns", Namespace)
+	}
+	for _, f := range info.fields {
+		if !f.flag {
+			continue
+		}
+		if value, ok := scalarString(v.FieldByIndex(f.index)); ok {
+			w.writeAttr(f.name, value)
+		}
+	}
+
+	if info.value != nil {
+		value, _ := scalarString(v.FieldByIndex(info.value.index))
+		w.buf.WriteString(">")
+		escape(w.buf, value)
+		w.buf.WriteString("</" + name + ">\n")
+		return
+	}
+
+	var children bytes.Buffer
+	child := &writer{buf: &children}
+	for _, f := range info.fields {
+		if !f.flag {
+			child.writeField(f, v.FieldByIndex(f.index), depth+1)
+		}
+	}
+	if children.Len() == 0 {
+		w.buf.WriteString("/>\n")
+		return
+	}
+	w.buf.WriteString(">\n")
+	w.buf.Write(children.Bytes())
+	w.indent(depth)
+	w.buf.WriteString("</" + name + ">\n")
+}
+
+func (w *writer) writeField(f *field, v reflect.Value, depth int) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch f.kind {
+	case kindScalar:
+		value, ok := scalarString(v)
+		if !ok {
+			return
+		}
+		if f.name == "prose" {
+			for _, block := range markdownBlocks(value) {
+				w.indent(depth)
+				w.buf.WriteString(block + "\n")
+			}
+			return
+		}
+		w.writeScalar(f.element, value, f.markup, depth)
+	case kindStruct:
+		w.writeStruct(f.element, v, depth, false)
+	case kindMap:
+		w.indent(depth)
+		w.buf.WriteString("<" + f.element + "/>\n")
+	case kindScalarSlice, kindStructSlice:
+		if v.Len() == 0 {
+			return
+		}
+		itemDepth := depth
+		if f.grouped {
+			w.indent(depth)
+			w.buf.WriteString("<" + f.name + ">\n")
+			itemDepth++
+		}
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			if f.kind == kindStructSlice {
+				w.writeStruct(f.element, reflect.Indirect(item), itemDepth, false)
+				continue
+			}
+			if value, ok := scalarString(item); ok {
+				w.writeScalar(f.element, value, f.markup, itemDepth)
+			}
+		}
+		if f.grouped {
+			w.indent(depth)
+			w.buf.WriteString("</" + f.name + ">\n")
+		}
+	}
+}
+
+func (w *writer) writeScalar(name, value string, markup markupKind, depth int) {
+	w.indent(depth)
+	w.buf.WriteString("<" + name + ">")
+	switch markup {
+	case markupMultiline:
+		w.buf.WriteString(strings.Join(markdownBlocks(value), ""))
+	case markupLine:
+		w.buf.WriteString(markdownInline(value))
+	default:
+		escape(w.buf, value)
+	}
+	w.buf.WriteString("</" + name + ">\n")
+}
+
+func (w *writer) writeAttr(name, value string) {
+	w.buf.WriteString(" " + name + `="`)
+	// EscapeText only fails on writer errors, which bytes.Buffer does not return.
+	_ = xml.EscapeText(w.buf, []byte(value))
+	w.buf.WriteString(`"`)
+}
+
+// textEscaper escapes element text. Unlike xml.EscapeText, line breaks are kept.
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escape(buf *bytes.Buffer, value string) {
+	buf.WriteString(textEscaper.Replace(value))
+}
+
+// scalarString returns the string value of a scalar and false for zero values.
+func scalarString(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.IsZero() {
+		return "", false
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339Nano), true
+	}
+	return "", false
+}
+
+// node is a parsed XML element with mixed content of strings and child nodes.
+type node struct {
+	name    string
+	attrs   []xml.Attr
+	content []interface{}
+}
+
+func (n *node) children() []*node {
+	var children []*node
+	for _, c := range n.content {
+		if child, ok := c.(*node); ok {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func (n *node) text() string {
+	var sb strings.Builder
+	for _, c := range n.content {
+		switch c := c.(type) {
+		case string:
+			sb.WriteString(c)
+		case *node:
+			sb.WriteString(c.text())
+		}
+	}
+	return sb.String()
+}
+
+func parse(reader io.Reader) (*node, error) {
+	dec := xml.NewDecoder(reader)
+	var stack []*node
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty XML document")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			n := &node{name: token.Name.Local, attrs: token.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.content = append(parent.content, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return n, nil
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.content = append(parent.content, string(token))
+			}
+		}
+	}
+}
+
+// decodeStruct returns the JSON representation of the XML element for the OSCAL type.
+func decodeStruct(n *node, t reflect.Type) (map[string]interface{}, error) {
+	info := infoOf(t)
+	out := make(map[string]interface{})
+
+	for _, attr := range n.attrs {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		f, ok := info.attributes[attr.Name.Local]
+		if !ok {
+			return nil, fmt.Errorf("unknown attribute %q in element %q", attr.Name.Local, n.name)
+		}
+		value, err := scalarValue(f.typ, attr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q in element %q: %w", attr.Name.Local, n.name, err)
+		}
+		out[f.name] = value
+	}
+
+	if info.value != nil {
+		value, err := scalarValue(info.value.typ, strings.TrimSpace(n.text()))
+		if err != nil {
+			return nil, fmt.Errorf("element %q: %w", n.name, err)
+		}
+		out[info.value.name] = value
+		return out, nil
+	}
+
+	var prose []interface{}
+	for _, child := range n.children() {
+		f, ok := info.elements[child.name]
+		if !ok {
+			if info.prose != nil && blockElements[child.name] {
+				prose = append(prose, child)
+				continue
+			}
+			return nil, fmt.Errorf("unknown element %q in element %q", child.name, n.name)
+		}
+
+		items := []*node{child}
+		if f.grouped && child.name == f.name {
+			items = child.children()
+		}
+		for _, item := range items {
+			value, err := decodeField(f, item)
+			if err != nil {
+				return nil, err
+			}
+			switch f.kind {
+			case kindScalarSlice, kindStructSlice:
+				values, _ := out[f.name].([]interface{})
+				out[f.name] = append(values, value)
+			default:
+				out[f.name] = value
+			}
+		}
+	}
+	if len(prose) > 0 {
+		out[info.prose.name] = markupToMarkdown(prose)
+	}
+	return out, nil
+}
+
+func decodeField(f *field, n *node) (interface{}, error) {
+	switch f.kind {
+	case kindStruct, kindStructSlice:
+		return decodeStruct(n, f.typ)
+	case kindMap:
+		return map[string]interface{}{}, nil
+	default:
+		// Markup is normalized like HTML and other strings are trimmed like the OSCAL
+		// XML converters do, so pretty-printed XML has no extra whitespace.
+		text := strings.TrimSpace(n.text())
+		if f.markup != markupNone || len(n.children()) > 0 {
+			text = markupToMarkdown(n.content)
+		}
+		value, err := scalarValue(f.typ, text)
+		if err != nil {
+			return nil, fmt.Errorf("element %q: %w", n.name, err)
+		}
+		return value, nil
+	}
+}
+
+// scalarValue returns the JSON value of the scalar type for the XML text.
+func scalarValue(t reflect.Type, text string) (interface{}, error) {
+	switch t.Kind() {
+	case reflect.Int:
+		value, err := strconv.Atoi(strings.TrimSpace(text))
+		if err != nil {
+			return nil, err
+		}
+		return value, nil
+	case reflect.Bool:
+		return strconv.ParseBool(strings.TrimSpace(text))
+	case reflect.Struct:
+		return strings.TrimSpace(text), nil
+	default:
+		return text, nil
+	}
+}
@@ -0,0 +1,466 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package oscalxml
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalUnmarshal_TestData(t *testing.T) {
+	files, err := filepath.Glob("../../testdata/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var want oscalTypes.OscalModels
+			require.NoError(t, json.Unmarshal(data, &want))
+
+			xmlData, err := Marshal(&want)
+			require.NoError(t, err)
+
+			var got oscalTypes.OscalModels
+			require.NoError(t, Unmarshal(xmlData, &got))
+			wantJSON, err := json.Marshal(want)
+			require.NoError(t, err)
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			require.JSONEq(t, string(wantJSON), string(gotJSON))
+		})
+	}
+}
+
+// TestUnmarshal_Upstream decodes OSCAL XML in the layout of the NIST and FedRAMP examples
+// and ensures it is encoded again in the same element order.
+func TestUnmarshal_Upstream(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var models oscalTypes.OscalModels
+			require.NoError(t, Unmarshal(data, &models))
+
+			xmlData, err := Marshal(&models)
+			require.NoError(t, err)
+			require.Equal(t, elementNames(t, data), elementNames(t, xmlData))
+
+			var got oscalTypes.OscalModels
+			require.NoError(t, Unmarshal(xmlData, &got))
+			require.Equal(t, models, got)
+		})
+	}
+
+	data, err := os.ReadFile("testdata/profile.xml")
+	require.NoError(t, err)
+	var models oscalTypes.OscalModels
+	require.NoError(t, Unmarshal(data, &models))
+	imp := models.Profile.Imports[0]
+	require.Equal(t, []string{"ac-1", "ac-2", "au-1"}, *(*imp.IncludeControls)[0].WithIds)
+	require.Equal(t, "ac-2.*", (*(*imp.ExcludeControls)[0].Matching)[0].Pattern)
+}
+
+func TestMarshal(t *testing.T) {
+	models := oscalTypes.OscalModels{
+		Catalog: &oscalTypes.Catalog{
+			UUID: "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d",
+			Metadata: oscalTypes.Metadata{
+				Title:        "Catalog & Friends",
+				Version:      "1.0.0",
+				OscalVersion: "1.1.3",
+				Revisions:    &[]oscalTypes.RevisionHistoryEntry{{Version: "0.1.0"}},
+			},
+			BackMatter: &oscalTypes.BackMatter{
+				Resources: &[]oscalTypes.Resource{
+					{
+						UUID:   "0b3a5c1e-2f43-4e8a-9d5b-7c6e1f2a3b4c",
+						Rlinks: &[]oscalTypes.ResourceLink{{Href: "catalog.json", Hashes: &[]oscalTypes.Hash{{Algorithm: "SHA-256", Value: "abc"}}}},
+					},
+				},
+			},
+			Controls: &[]oscalTypes.Control{
+				{
+					ID:    "ac-1",
+					Title: "Policy *and* Procedures",
+					Parts: &[]oscalTypes.Part{
+						{Name: "statement", Prose: "First paragraph.\n\n- item one\n- item two"},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := Marshal(&models)
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" uuid="6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d">
+  <metadata>
+    <title>Catalog &amp; Friends</title>
+    <version>1.0.0</version>
+    <oscal-version>1.1.3</oscal-version>
+    <revisions>
+      <revision>
+        <version>0.1.0</version>
+      </revision>
+    </revisions>
+  </metadata>
+  <control id="ac-1">
+    <title>Policy <em>and</em> Procedures</title>
+    <part name="statement">
+      <p>First paragraph.</p>
+      <ul><li>item one</li><li>item two</li></ul>
+    </part>
+  </control>
+  <back-matter>
+    <resource uuid="0b3a5c1e-2f43-4e8a-9d5b-7c6e1f2a3b4c">
+      <rlink href="catalog.json">
+        <hash algorithm="SHA-256">abc</hash>
+      </rlink>
+    </resource>
+  </back-matter>
+</catalog>
+`, string(data))
+
+	var got oscalTypes.OscalModels
+	require.NoError(t, Unmarshal(data, &got))
+	require.Equal(t, models, got)
+
+	_, err = Marshal(&oscalTypes.OscalModels{})
+	require.ErrorIs(t, err, ErrNoModel)
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    oscalTypes.OscalModels
+		wantErr string
+	}{
+		{
+			name: "Valid/Markup",
+			data: `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" uuid="c1">
+  <metadata>
+    <title>Catalog</title>
+    <last-modified>2025-01-01T00:00:00Z</last-modified>
+    <version>1.0.0</version>
+    <oscal-version>1.1.3</oscal-version>
+    <remarks>
+      <p>Use <strong>this</strong> catalog.</p>
+      <p>See <a href="https://example.com">the site</a>.</p>
+    </remarks>
+  </metadata>
+  <group id="ac">
+    <title>Access Control</title>
+    <control id="ac-1">
+      <title>Policy</title>
+      <param id="ac-1_prm_1">
+        <select how-many="one-or-more">
+          <choice>yearly</choice>
+          <choice><em>monthly</em></choice>
+        </select>
+      </param>
+      <part name="statement">
+        <p>Review <insert type="param" id-ref="ac-1_prm_1"/>.</p>
+        <ol>
+          <li>first</li>
+          <li>second<ul><li>nested</li></ul></li>
+        </ol>
+      </part>
+    </control>
+  </group>
+</catalog>`,
+			want: oscalTypes.OscalModels{
+				Catalog: &oscalTypes.Catalog{
+					UUID: "c1",
+					Metadata: oscalTypes.Metadata{
+						Title:        "Catalog",
+						LastModified: mustParseTime(t, "2025-01-01T00:00:00Z"),
+						Version:      "1.0.0",
+						OscalVersion: "1.1.3",
+						Remarks:      "Use **this** catalog.\n\nSee [the site](https://example.com).",
+					},
+					Groups: &[]oscalTypes.Group{
+						{
+							ID:    "ac",
+							Title: "Access Control",
+							Controls: &[]oscalTypes.Control{
+								{
+									ID:    "ac-1",
+									Title: "Policy",
+									Params: &[]oscalTypes.Parameter{
+										{
+											ID: "ac-1_prm_1",
+											Select: &oscalTypes.ParameterSelection{
+												HowMany: "one-or-more",
+												Choice:  &[]string{"yearly", "*monthly*"},
+											},
+										},
+									},
+									Parts: &[]oscalTypes.Part{
+										{
+											Name:  "statement",
+											Prose: "Review {{ insert: param, ac-1_prm_1 }}.\n\n1. first\n2. second\n  - nested",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "Invalid/UnknownElement",
+			data:    `<catalog uuid="c1"><unknown/></catalog>`,
+			wantErr: `unknown element "unknown" in element "catalog"`,
+		},
+		{
+			name:    "Invalid/UnknownAttribute",
+			data:    `<catalog id="c1"/>`,
+			wantErr: `unknown attribute "id" in element "catalog"`,
+		},
+		{
+			name:    "Invalid/UnknownRoot",
+			data:    `<not-oscal/>`,
+			wantErr: `unknown root element "not-oscal"`,
+		},
+		{
+			name:    "Invalid/Syntax",
+			data:    `<catalog>`,
+			wantErr: "XML syntax error on line 1: unexpected EOF",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			var got oscalTypes.OscalModels
+			err := Unmarshal([]byte(c.data), &got)
+			if c.wantErr != "" {
+				require.EqualError(t, err, c.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.want, got)
+		})
+	}
+}
+
+// TestTypeOrder ensures the XML element order is defined for all OSCAL types with more
+// than one element.
+func TestTypeOrder(t *testing.T) {
+	seen := make(map[reflect.Type]bool)
+	var walk func(reflect.Type)
+	walk = func(typ reflect.Type) {
+		if seen[typ] {
+			return
+		}
+		seen[typ] = true
+
+		info := infoOf(typ)
+		var elements []string
+		for _, f := range info.fields {
+			if !f.flag {
+				elements = append(elements, f.name)
+			}
+			if f.kind == kindStruct || f.kind == kindStructSlice {
+				walk(f.typ)
+			}
+			if f.kind == kindStructSlice || f.kind == kindScalarSlice {
+				_, found := arrayElements[f.name]
+				require.True(t, found, "type %s: no XML element name for %q", typ.Name(), f.name)
+			}
+		}
+		if typ.Name() == "OscalCompleteSchema" || typ.Name() == "OscalModels" {
+			return
+		}
+		if order, ok := typeOrder[typ.Name()]; ok {
+			require.ElementsMatch(t, order, elements, "type %s", typ.Name())
+		} else {
+			require.LessOrEqual(t, len(elements), 1, "type %s: no XML element order for %v", typ.Name(), elements)
+		}
+	}
+	walk(reflect.TypeOf(oscalTypes.OscalModels{}))
+}
+
+// TestTypeInfo ensures the XML element names of all OSCAL types are unambiguous.
+func TestTypeInfo(t *testing.T) {
+	seen := make(map[reflect.Type]bool)
+	var walk func(reflect.Type)
+	walk = func(typ reflect.Type) {
+		if seen[typ] {
+			return
+		}
+		seen[typ] = true
+
+		names := make(map[string]string)
+		for _, f := range infoOf(typ).fields {
+			key := f.element
+			if f.flag {
+				key = "@" + f.name
+			} else if f.grouped {
+				key = f.name
+			}
+			if other, ok := names[key]; ok {
+				t.Errorf("type %s: %q and %q are both bound to %q", typ.Name(), other, f.name, key)
+			}
+			names[key] = f.name
+			if f.kind == kindStruct || f.kind == kindStructSlice {
+				walk(f.typ)
+			}
+		}
+	}
+	walk(reflect.TypeOf(oscalTypes.OscalModels{}))
+	require.Greater(t, len(seen), 100)
+}
+
+// elementNames returns the names of all elements of an XML document in document order.
+func elementNames(t *testing.T, data []byte) []string {
+	var names []string
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return names
+		}
+		require.NoError(t, err)
+		if start, ok := token.(xml.StartElement); ok {
+			names = append(names, start.Name.Local)
+		}
+	}
+}
+
+// metaschemaFlags are the scalar properties bound to XML attributes by the OSCAL 1.1.3
+// metaschema in at least one type.
+var metaschemaFlags = map[string]bool{
+	"activity-uuid": true, "actor-uuid": true, "algorithm": true, "by-class": true, "by-id": true,
+	"by-item-name": true, "by-name": true, "by-ns": true, "class": true, "component-uuid": true,
+	"control-id": true, "date": true, "depends-on": true, "end": true, "filename": true,
+	"finding-uuid": true, "group": true, "how-many": true, "href": true, "id": true,
+	"identifier-type": true, "implementation-uuid": true, "lifecycle": true, "media-type": true,
+	"method": true, "name": true, "ns": true, "objective-id": true, "observation-uuid": true,
+	"order": true, "param-id": true, "party-uuid": true, "pattern": true, "period": true,
+	"position": true, "provided-uuid": true, "rel": true, "resource-fragment": true,
+	"response-uuid": true, "responsibility-uuid": true, "risk-uuid": true, "role-id": true,
+	"scheme": true, "source": true, "start": true, "state": true, "statement-id": true,
+	"subject-placeholder-uuid": true, "subject-uuid": true, "system": true, "target-id": true,
+	"task-uuid": true, "transport": true, "type": true, "unit": true, "uuid": true, "value": true,
+	"with-child-controls": true,
+}
+
+// TestBindingCoverage ensures every scalar property of every OSCAL type is bound to a known
+// metaschema flag or field, and every entry of the binding tables names an existing property.
+func TestBindingCoverage(t *testing.T) {
+	types := make(map[string]reflect.Type)
+	var walk func(reflect.Type)
+	walk = func(typ reflect.Type) {
+		if _, ok := types[typ.Name()]; ok {
+			return
+		}
+		types[typ.Name()] = typ
+
+		info := infoOf(typ)
+		for _, f := range info.fields {
+			switch {
+			case f.kind == kindStruct || f.kind == kindStructSlice:
+				walk(f.typ)
+			case f.kind != kindScalar || f == info.prose:
+			case f.flag:
+				require.True(t, metaschemaFlags[f.name], "type %s: %q is not a metaschema flag", typ.Name(), f.name)
+			default:
+				require.True(t, elementScalars[f.name] || typeElements[typ.Name()][f.name],
+					"type %s: %q is not a metaschema field", typ.Name(), f.name)
+			}
+		}
+	}
+	walk(reflect.TypeOf(oscalTypes.OscalModels{}))
+
+	hasProperty := func(typeName, name string) {
+		typ, ok := types[typeName]
+		require.True(t, ok, "unknown type %s", typeName)
+		for i := 0; i < typ.NumField(); i++ {
+			if jsonName, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ","); jsonName == name {
+				return
+			}
+		}
+		t.Errorf("type %s has no property %q", typeName, name)
+	}
+	for typeName, names := range typeElements {
+		for name := range names {
+			hasProperty(typeName, name)
+		}
+	}
+	for typeName, names := range typeFlags {
+		for name := range names {
+			hasProperty(typeName, name)
+		}
+	}
+	for typeName, names := range typeArrayElements {
+		for name := range names {
+			hasProperty(typeName, name)
+		}
+	}
+	for typeName, name := range valueKeys {
+		hasProperty(typeName, name)
+	}
+	for typeName, names := range typeOrder {
+		for _, name := range names {
+			hasProperty(typeName, name)
+		}
+	}
+}
+
+func TestUnmarshal_Whitespace(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" uuid="74c8ba1e-5cd4-4ad1-bbfd-d888e2f6c724">
+  <metadata>
+    <title>
+      Example   Catalog
+    </title>
+    <last-modified>
+      2024-01-01T00:00:00Z
+    </last-modified>
+    <version>
+      1.0
+    </version>
+    <oscal-version>1.1.3</oscal-version>
+  </metadata>
+  <control id="ac-1">
+    <title>Policy and Procedures</title>
+    <part id="ac-1_smt" name="statement">
+      <p>
+        Develop and document
+        an access control <em>policy</em>.
+      </p>
+    </part>
+  </control>
+</catalog>`)
+
+	var models oscalTypes.OscalModels
+	require.NoError(t, Unmarshal(data, &models))
+	require.Equal(t, "Example Catalog", models.Catalog.Metadata.Title)
+	require.Equal(t, "1.0", models.Catalog.Metadata.Version)
+	require.Equal(t, "2024-01-01T00:00:00Z", models.Catalog.Metadata.LastModified.Format(time.RFC3339))
+	part := (*(*models.Catalog.Controls)[0].Parts)[0]
+	require.Equal(t, "Develop and document\nan access control *policy*.", part.Prose)
+}
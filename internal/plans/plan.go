@@ -9,10 +9,10 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
 	"github.com/oscal-compass/oscal-sdk-go/models"
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
@@ -26,8 +26,9 @@ const (
 )
 
 type generateOpts struct {
-	title     string
-	importSSP string
+	title       string
+	importSSP   string
+	identifiers identifiers.Generator
 }
 
 func (g *generateOpts) defaults() {
@@ -55,6 +56,17 @@ func WithImport(importSSP string) GenerateOption {
 	}
 }
 
+// WithDeterministicUUIDs is a GenerateOption that derives name-based (version 5)
+// UUIDs from stable input keys, such as the component UUID and rule ID for activities,
+// so generating a plan from the same inputs produces the same UUIDs. The seed
+// distinguishes plans generated from the same inputs and may be empty.
+// The metadata last-modified time is still the current time.
+func WithDeterministicUUIDs(seed string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.identifiers = identifiers.NewDeterministic(seed)
+	}
+}
+
 // GenerateAssessmentPlan generates an AssessmentPlan for a set of Components and ImplementationSettings. The chosen inputs allow an Assessment Plan to be generated from
 // a set of OSCAL ComponentDefinitions or a SystemSecurityPlan.
 //
@@ -75,7 +87,7 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 		allActivities    []oscalTypes.Activity
 		subjectSelectors []oscalTypes.SelectSubjectById
 		localComponents  []components.Component
-		ruleBasedTask    = newTask()
+		ruleBasedTask    = newTask(options.identifiers)
 	)
 
 	for _, comp := range comps {
@@ -85,7 +97,13 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 			continue
 		}
 		compTitle := comp.Title()
-		componentActivities, err := ActivitiesForComponent(ctx, compTitle, memoryStore, implementationSettings)
+		// Rules are looked up by UUID so components with the same title
+		// from different definitions keep their own rules.
+		appliedRules, err := settings.ApplyToComponentUUID(ctx, comp.UUID(), memoryStore, implementationSettings.AllSettings())
+		if err != nil {
+			return nil, fmt.Errorf("error generating assessment activities for component %s: %w", compTitle, err)
+		}
+		componentActivities, err := activitiesForRules(comp.UUID(), appliedRules, implementationSettings, options.identifiers)
 		if err != nil {
 			return nil, fmt.Errorf("error generating assessment activities for component %s: %w", compTitle, err)
 		}
@@ -115,7 +133,7 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 		}
 	}
 
-	assessmentAssets := AssessmentAssets(comps)
+	assessmentAssets := assessmentAssets(comps, options.identifiers)
 	taskSubjects := oscalTypes.AssessmentSubject{
 		IncludeSubjects: &subjectSelectors,
 		Type:            defaultSubjectType,
@@ -126,7 +144,7 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 	metadata.Title = options.title
 
 	assessmentPlan := &oscalTypes.AssessmentPlan{
-		UUID: uuid.NewUUID(),
+		UUID: options.identifiers.UUID("assessment-plan", options.title, options.importSSP),
 		ImportSsp: oscalTypes.ImportSsp{
 			Href: options.importSSP,
 		},
@@ -147,10 +165,11 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 }
 
 // newTask creates a new OSCAL Task with default values.
-func newTask() oscalTypes.Task {
+func newTask(ids identifiers.Generator) oscalTypes.Task {
+	const title = "Automated Assessment"
 	return oscalTypes.Task{
-		UUID:                 uuid.NewUUID(),
-		Title:                "Automated Assessment",
+		UUID:                 ids.UUID("task", title),
+		Title:                title,
 		Type:                 defaultTaskType,
 		Description:          "Evaluation of defined rules for components.",
 		Subjects:             &[]oscalTypes.AssessmentSubject{},
@@ -158,24 +177,29 @@ func newTask() oscalTypes.Task {
 	}
 }
 
-// ActivitiesForComponent returns a list of activities with for a given component Title.
+// ActivitiesForComponent returns a list of activities for the component with the given UUID.
 //
 // The mapping between a RuleSet and Activity is as follows:
 // Rule -> Activity
 // ID -> Title
 // Parameter -> Activity Property
 // Check -> Activity Step
-func ActivitiesForComponent(ctx context.Context, targetComponentID string, store rules.Store, implementationSettings settings.ImplementationSettings) ([]oscalTypes.Activity, error) {
+func ActivitiesForComponent(ctx context.Context, componentUUID string, store rules.Store, implementationSettings settings.ImplementationSettings) ([]oscalTypes.Activity, error) {
+	appliedRules, err := settings.ApplyToComponentUUID(ctx, componentUUID, store, implementationSettings.AllSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting applied rules for component %s: %w", componentUUID, err)
+	}
+	return activitiesForRules(componentUUID, appliedRules, implementationSettings, identifiers.Generator{})
+}
+
+// activitiesForRules returns the activities for the applied rules of a component. The component
+// key and rule ID are the keys for the activity UUIDs and the check ID is added for step UUIDs.
+func activitiesForRules(componentKey string, appliedRules []extensions.RuleSet, implementationSettings settings.ImplementationSettings, ids identifiers.Generator) ([]oscalTypes.Activity, error) {
 	methodProp := oscalTypes.Property{
 		Name:  "method",
 		Value: "TEST",
 	}
 
-	appliedRules, err := settings.ApplyToComponent(ctx, targetComponentID, store, implementationSettings.AllSettings())
-	if err != nil {
-		return nil, fmt.Errorf("error getting applied rules for component %s: %w", targetComponentID, err)
-	}
-
 	var activities []oscalTypes.Activity
 	for _, rule := range appliedRules {
 		relatedControls, err := ReviewedControls(rule.Rule.ID, implementationSettings)
@@ -186,7 +210,7 @@ func ActivitiesForComponent(ctx context.Context, targetComponentID string, store
 		var steps []oscalTypes.Step
 		for _, check := range rule.Checks {
 			checkStep := oscalTypes.Step{
-				UUID:        uuid.NewUUID(),
+				UUID:        ids.UUID("step", componentKey, rule.Rule.ID, check.ID),
 				Title:       check.ID,
 				Description: check.Description,
 			}
@@ -194,7 +218,7 @@ func ActivitiesForComponent(ctx context.Context, targetComponentID string, store
 		}
 
 		activity := oscalTypes.Activity{
-			UUID:            uuid.NewUUID(),
+			UUID:            ids.UUID("activity", componentKey, rule.Rule.ID),
 			Description:     rule.Rule.Description,
 			Props:           &[]oscalTypes.Property{methodProp},
 			RelatedControls: &relatedControls,
@@ -202,14 +226,22 @@ func ActivitiesForComponent(ctx context.Context, targetComponentID string, store
 			Steps:           modelutils.NilIfEmpty(&steps),
 		}
 
+		// Multi-valued parameters, whether set by a set-parameter or by their default
+		// values, are added as one property per value.
 		for _, rp := range rule.Rule.Parameters {
-			parameterProp := oscalTypes.Property{
-				Name:  rp.ID,
-				Value: rp.Value,
-				Ns:    extensions.TrestleNameSpace,
-				Class: extensions.TestParameterClass,
+			values := rp.SelectedValues()
+			if len(values) == 0 {
+				values = []string{rp.Value}
+			}
+			for _, value := range values {
+				parameterProp := oscalTypes.Property{
+					Name:  rp.ID,
+					Value: value,
+					Ns:    extensions.TrestleNameSpace,
+					Class: extensions.TestParameterClass,
+				}
+				*activity.Props = append(*activity.Props, parameterProp)
 			}
-			*activity.Props = append(*activity.Props, parameterProp)
 		}
 		activities = append(activities, activity)
 	}
@@ -280,6 +312,10 @@ func AssessmentActivities(subject oscalTypes.AssessmentSubject, activities []osc
 
 // AssessmentAssets returns AssessmentAssets from validation components defined in the given DefinedComponents.
 func AssessmentAssets(comps []components.Component) oscalTypes.AssessmentAssets {
+	return assessmentAssets(comps, identifiers.Generator{})
+}
+
+func assessmentAssets(comps []components.Component, ids identifiers.Generator) oscalTypes.AssessmentAssets {
 	var systemComponents []oscalTypes.SystemComponent
 	var usedComponents []oscalTypes.UsesComponent
 	for _, component := range comps {
@@ -299,8 +335,12 @@ func AssessmentAssets(comps []components.Component) oscalTypes.AssessmentAssets
 	}
 
 	// AssessmentPlatforms is a required field under AssessmentAssets
+	platformKeys := []string{"assessment-platform"}
+	for _, usedComponent := range usedComponents {
+		platformKeys = append(platformKeys, usedComponent.ComponentUuid)
+	}
 	assessmentPlatform := oscalTypes.AssessmentPlatform{
-		UUID:           uuid.NewUUID(),
+		UUID:           ids.UUID(platformKeys...),
 		Title:          models.SampleRequiredString,
 		UsesComponents: modelutils.NilIfEmpty(&usedComponents),
 	}
@@ -22,6 +22,9 @@ import (
 	"github.com/oscal-compass/oscal-sdk-go/validation"
 )
 
+// testKubernetesUUID is the UUID of the TestKubernetes component in the test component definition.
+const testKubernetesUUID = "c8106bc8-5174-4e86-91a4-52f2fe0ed027"
+
 func TestGenerateAssessmentPlan(t *testing.T) {
 	testComp := readCompDef(t)
 	defaultComponents := prepComponents(t, testComp)
@@ -92,6 +95,96 @@ func TestGenerateAssessmentPlan(t *testing.T) {
 	}
 }
 
+func TestGenerateAssessmentPlan_DeterministicUUIDs(t *testing.T) {
+	testComp := readCompDef(t)
+	defaultComponents := prepComponents(t, testComp)
+	defaultSettings := prepSettings(t, testComp)
+
+	tests := []struct {
+		name       string
+		firstOpts  []GenerateOption
+		secondOpts []GenerateOption
+		wantEqual  bool
+	}{
+		{
+			name:       "Random",
+			firstOpts:  nil,
+			secondOpts: nil,
+			wantEqual:  false,
+		},
+		{
+			name:       "Deterministic/SameSeed",
+			firstOpts:  []GenerateOption{WithDeterministicUUIDs("cis")},
+			secondOpts: []GenerateOption{WithDeterministicUUIDs("cis")},
+			wantEqual:  true,
+		},
+		{
+			name:       "Deterministic/DifferentSeed",
+			firstOpts:  []GenerateOption{WithDeterministicUUIDs("cis")},
+			secondOpts: []GenerateOption{WithDeterministicUUIDs("nist")},
+			wantEqual:  false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			first, err := GenerateAssessmentPlan(context.TODO(), defaultComponents, defaultSettings, c.firstOpts...)
+			require.NoError(t, err)
+			second, err := GenerateAssessmentPlan(context.TODO(), defaultComponents, defaultSettings, c.secondOpts...)
+			require.NoError(t, err)
+
+			firstUUIDs, secondUUIDs := planUUIDs(first), planUUIDs(second)
+			require.Len(t, firstUUIDs, 7)
+			if c.wantEqual {
+				require.Equal(t, firstUUIDs, secondUUIDs)
+			} else {
+				for i := range firstUUIDs {
+					require.NotEqual(t, firstUUIDs[i], secondUUIDs[i])
+				}
+			}
+		})
+	}
+}
+
+// planUUIDs returns the UUIDs generated for an assessment plan in document order.
+func planUUIDs(plan *oscalTypes.AssessmentPlan) []string {
+	uuids := []string{plan.UUID}
+	for _, activity := range *plan.LocalDefinitions.Activities {
+		uuids = append(uuids, activity.UUID)
+		for _, step := range *activity.Steps {
+			uuids = append(uuids, step.UUID)
+		}
+	}
+	for _, platform := range plan.AssessmentAssets.AssessmentPlatforms {
+		uuids = append(uuids, platform.UUID)
+	}
+	for _, task := range *plan.Tasks {
+		uuids = append(uuids, task.UUID)
+	}
+	return uuids
+}
+
+func TestGenerateAssessmentPlan_TargetComponents(t *testing.T) {
+	file, err := os.Open(filepath.Join("../../testdata", "component-definition-target-components.json"))
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	plan, err := GenerateAssessmentPlan(context.TODO(), prepComponents(t, *definition), prepSettings(t, *definition))
+	require.NoError(t, err)
+
+	// One validation component provides the checks for each target component.
+	stepsByActivity := make(map[string][]string)
+	for _, activity := range *plan.LocalDefinitions.Activities {
+		require.NotNil(t, activity.Steps)
+		for _, step := range *activity.Steps {
+			stepsByActivity[activity.Title] = append(stepsByActivity[activity.Title], step.Title)
+		}
+	}
+	require.Len(t, *plan.LocalDefinitions.Activities, 3)
+	require.Equal(t, []string{"etcd_key_file_kubernetes", "etcd_key_file_openshift"}, stepsByActivity["etcd_key_file"])
+	require.Equal(t, []string{"audit_log_enabled_kubernetes"}, stepsByActivity["audit_log_enabled"])
+}
+
 func TestActivitiesForComponent(t *testing.T) {
 	compDef := readCompDef(t)
 	testComponents := prepComponents(t, compDef)
@@ -100,7 +193,7 @@ func TestActivitiesForComponent(t *testing.T) {
 	memoryStore := rules.NewMemoryStore()
 	require.NoError(t, memoryStore.IndexAll(testComponents))
 
-	gotActivities, err := ActivitiesForComponent(context.TODO(), "TestKubernetes", memoryStore, defaultSettings)
+	gotActivities, err := ActivitiesForComponent(context.TODO(), testKubernetesUUID, memoryStore, defaultSettings)
 	require.NoError(t, err)
 
 	require.Len(t, gotActivities, 2)
@@ -145,6 +238,101 @@ func TestActivitiesForComponent(t *testing.T) {
 	require.Equal(t, expectedProps, *gotActivity.Props)
 
 }
+func TestActivitiesForComponent_MultiValuedParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(definition oscalTypes.ComponentDefinition)
+	}{
+		{
+			name: "Success/SetParameters",
+			modify: func(definition oscalTypes.ComponentDefinition) {
+				for _, implementation := range controlImplementations(definition) {
+					if implementation.SetParameters == nil {
+						continue
+					}
+					for i := range *implementation.SetParameters {
+						(*implementation.SetParameters)[i].Values = []string{"cert.key", "server.key"}
+					}
+				}
+			},
+		},
+		{
+			name: "Success/Defaults",
+			modify: func(definition oscalTypes.ComponentDefinition) {
+				for _, implementation := range controlImplementations(definition) {
+					implementation.SetParameters = nil
+				}
+				for _, component := range *definition.Components {
+					if component.Props == nil {
+						continue
+					}
+					for i, prop := range *component.Props {
+						if prop.Name == extensions.ParameterDefaultProp {
+							// Only the defaults of list parameters are split.
+							(*component.Props)[i].Value = "cert.key, server.key"
+							typeProp := prop
+							typeProp.Name = extensions.ParameterTypeProp
+							typeProp.Value = string(extensions.ParameterTypeList)
+							*component.Props = append(*component.Props, typeProp)
+						}
+					}
+				}
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			compDef := readCompDef(t)
+			c.modify(compDef)
+			testComponents := prepComponents(t, compDef)
+			multiValuedSettings := prepSettings(t, compDef)
+
+			memoryStore := rules.NewMemoryStore()
+			require.NoError(t, memoryStore.IndexAll(testComponents))
+
+			gotActivities, err := ActivitiesForComponent(context.TODO(), testKubernetesUUID, memoryStore, multiValuedSettings)
+			require.NoError(t, err)
+
+			var gotActivity oscalTypes.Activity
+			for _, activity := range gotActivities {
+				if activity.Title == "etcd_key_file" {
+					gotActivity = activity
+					break
+				}
+			}
+			require.NotNil(t, gotActivity.Props)
+			parameterProps := extensions.FindAllProps(*gotActivity.Props, extensions.WithClass(extensions.TestParameterClass))
+			require.Equal(t, []oscalTypes.Property{
+				{Name: "file_name", Value: "cert.key", Ns: extensions.TrestleNameSpace, Class: "test-parameter"},
+				{Name: "file_name", Value: "server.key", Ns: extensions.TrestleNameSpace, Class: "test-parameter"},
+			}, parameterProps)
+
+			// The values are read back from the activities.
+			activitySettings := settings.NewAssessmentActivitiesSettings(gotActivities)
+			ruleSet, err := memoryStore.GetByRuleID(context.TODO(), "etcd_key_file")
+			require.NoError(t, err)
+			appliedRuleSet, err := activitySettings.ApplyParameterSettings(ruleSet)
+			require.NoError(t, err)
+			require.Equal(t, []string{"cert.key", "server.key"}, appliedRuleSet.Rule.Parameters[0].SelectedValues())
+		})
+	}
+}
+
+// controlImplementations returns the control implementations of all components.
+func controlImplementations(definition oscalTypes.ComponentDefinition) []*oscalTypes.ControlImplementationSet {
+	var implementations []*oscalTypes.ControlImplementationSet
+	for _, component := range *definition.Components {
+		if component.ControlImplementations == nil {
+			continue
+		}
+		for i := range *component.ControlImplementations {
+			implementations = append(implementations, &(*component.ControlImplementations)[i])
+		}
+	}
+	return implementations
+}
+
 func readCompDef(t *testing.T) oscalTypes.ComponentDefinition {
 	testDataPath := filepath.Join("../../testdata", "component-definition-test.json")
 
@@ -0,0 +1,7 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package poams defines logic for working with OSCAL Plans of Action and Milestones.
+package poams
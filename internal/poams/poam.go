@@ -0,0 +1,392 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"fmt"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+	poammanager "github.com/oscal-compass/oscal-sdk-go/models/poams"
+)
+
+const (
+	// riskStatusOpen is the status of risks that are tracked by POA&M items.
+	riskStatusOpen = "open"
+	// stateNotSatisfied is the finding target state of findings that are tracked by POA&M items.
+	stateNotSatisfied = "not-satisfied"
+)
+
+type generateOpts struct {
+	title       string
+	importSSP   string
+	existing    *oscalTypes.PlanOfActionAndMilestones
+	identifiers identifiers.Generator
+}
+
+func (g *generateOpts) defaults() {
+	g.title = models.SampleRequiredString
+}
+
+// GenerateOption defines an option to tune the behavior of the
+// GeneratePOAM function.
+type GenerateOption func(opts *generateOpts)
+
+// WithTitle is a GenerateOption that sets the POA&M title
+// in the metadata of a new POA&M.
+func WithTitle(title string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.title = title
+	}
+}
+
+// WithImport is a GenerateOption that sets the SystemSecurityPlan
+// ImportSSP Href value. Without it, a new POA&M gets a placeholder Href
+// and an existing POA&M keeps its Href.
+func WithImport(importSSP string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.importSSP = importSSP
+	}
+}
+
+// WithPOAM is a GenerateOption that merges the POA&M items into an existing POA&M
+// instead of generating a new POA&M. The existing POA&M is updated in place.
+func WithPOAM(existing *oscalTypes.PlanOfActionAndMilestones) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.existing = existing
+	}
+}
+
+// WithDeterministicUUIDs is a GenerateOption that derives name-based (version 5)
+// UUIDs from stable input keys, such as the finding target for POA&M items, so
+// generating a POA&M from the same inputs produces the same UUIDs. The seed
+// distinguishes POA&Ms generated from the same inputs and may be empty.
+func WithDeterministicUUIDs(seed string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.identifiers = identifiers.NewDeterministic(seed)
+	}
+}
+
+// GeneratePOAM generates a Plan of Action and Milestones from AssessmentResults.
+//
+// Each not-satisfied Finding gets a POA&M item that relates to the Finding, its Observations
+// and its Risks. Each open Risk that is not related to such a Finding gets a POA&M item that
+// relates to the Risk and its Observations. The related Findings, Observations and Risks are
+// copied into the POA&M.
+//
+// Items are tracked by the target of the Finding or the rule of the Risk in their properties.
+// If `WithPOAM` is set, existing items with the same target or rule are updated with the new
+// relations instead of adding a new item. Risks without a rule always get a new item. Closed
+// items that get new relations are reopened with their Risks, and the status change is
+// recorded in the item history as with poams.Manager.Reopen.
+//
+// The metadata last-modified time of the POA&M and the time of status changes is the
+// last-modified time of the AssessmentResults, so the same inputs give the same POA&M.
+// The current time is used if the AssessmentResults have no last-modified time.
+func GeneratePOAM(assessmentResults oscalTypes.AssessmentResults, opts ...GenerateOption) (*oscalTypes.PlanOfActionAndMilestones, error) {
+	options := generateOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	lastModified := assessmentResults.Metadata.LastModified
+	if lastModified.IsZero() {
+		lastModified = time.Now()
+	}
+	poam := options.existing
+	if poam == nil {
+		importSSP := options.importSSP
+		if importSSP == "" {
+			importSSP = models.SampleRequiredString
+		}
+		metadata := models.NewSampleMetadata()
+		metadata.Title = options.title
+		poam = &oscalTypes.PlanOfActionAndMilestones{
+			UUID:      options.identifiers.UUID("poam", options.title, importSSP, assessmentResults.UUID),
+			Metadata:  metadata,
+			ImportSsp: &oscalTypes.ImportSsp{Href: importSSP},
+			PoamItems: make([]oscalTypes.PoamItem, 0), // Required field
+		}
+	} else if options.importSSP != "" {
+		poam.ImportSsp = &oscalTypes.ImportSsp{Href: options.importSSP}
+	}
+	poam.Metadata.LastModified = lastModified
+
+	m := newMerger(poam, options.identifiers, lastModified)
+	for _, result := range assessmentResults.Results {
+		if err := m.mergeResult(result); err != nil {
+			return nil, fmt.Errorf("result %s: %w", result.UUID, err)
+		}
+	}
+	m.apply()
+	if err := m.reopen(); err != nil {
+		return nil, err
+	}
+	return poam, nil
+}
+
+// merger merges the items of results into a POA&M.
+type merger struct {
+	poam         *oscalTypes.PlanOfActionAndMilestones
+	identifiers  identifiers.Generator
+	itemsByKey   map[string]int
+	findings     []oscalTypes.Finding
+	findingIDs   set.Set[string]
+	observations []oscalTypes.Observation
+	observedIDs  set.Set[string]
+	risks        []oscalTypes.Risk
+	riskIDs      set.Set[string]
+	// manager manages the status of the tracked items.
+	manager *poammanager.Manager
+	// reopened stores the UUIDs of the closed items with new relations in merge order
+	// mapped to the reason for reopening them.
+	reopened      map[string]string
+	reopenedOrder []string
+}
+
+// newMerger returns a merger for the POA&M. Status changes of items are recorded at the given time.
+func newMerger(poam *oscalTypes.PlanOfActionAndMilestones, ids identifiers.Generator, changed time.Time) *merger {
+	m := &merger{
+		poam:        poam,
+		identifiers: ids,
+		itemsByKey:  make(map[string]int),
+		findingIDs:  set.New[string](),
+		observedIDs: set.New[string](),
+		riskIDs:     set.New[string](),
+		manager:     poammanager.NewManager(poam, poammanager.WithClock(func() time.Time { return changed })),
+		reopened:    make(map[string]string),
+	}
+	for i, item := range poam.PoamItems {
+		if key := itemKey(item); key != "" {
+			m.itemsByKey[key] = i
+		}
+	}
+	if poam.Findings != nil {
+		for _, finding := range *poam.Findings {
+			m.addFinding(finding)
+		}
+	}
+	if poam.Observations != nil {
+		for _, observation := range *poam.Observations {
+			m.addObservation(observation)
+		}
+	}
+	if poam.Risks != nil {
+		for _, risk := range *poam.Risks {
+			m.addRisk(risk)
+		}
+	}
+	return m
+}
+
+// mergeResult adds or updates the items for the not-satisfied findings and open risks
+// of a result.
+func (m *merger) mergeResult(result oscalTypes.Result) error {
+	observationsByUUID := make(map[string]oscalTypes.Observation)
+	if result.Observations != nil {
+		for _, observation := range *result.Observations {
+			observationsByUUID[observation.UUID] = observation
+		}
+	}
+	risksByUUID := make(map[string]oscalTypes.Risk)
+	if result.Risks != nil {
+		for _, risk := range *result.Risks {
+			risksByUUID[risk.UUID] = risk
+		}
+	}
+	relateObservations := func(item *oscalTypes.PoamItem, related *[]oscalTypes.RelatedObservation) error {
+		if related == nil {
+			return nil
+		}
+		for _, relatedObservation := range *related {
+			observation, ok := observationsByUUID[relatedObservation.ObservationUuid]
+			if !ok {
+				return fmt.Errorf("observation %s not found", relatedObservation.ObservationUuid)
+			}
+			m.addObservation(observation)
+			item.RelatedObservations = appendUnique(item.RelatedObservations, relatedObservation, func(o oscalTypes.RelatedObservation) string { return o.ObservationUuid })
+		}
+		return nil
+	}
+
+	trackedRisks := set.New[string]()
+	if result.Findings != nil {
+		for _, finding := range *result.Findings {
+			if finding.Target.Status.State != stateNotSatisfied {
+				continue
+			}
+			item, err := m.item(extensions.FindingTargetProp, finding.Target.TargetId, finding.Title, finding.Description,
+				fmt.Sprintf("Findings not satisfied in assessment result %s", result.UUID))
+			if err != nil {
+				return fmt.Errorf("finding %s: %w", finding.UUID, err)
+			}
+			m.addFinding(finding)
+			item.RelatedFindings = appendUnique(item.RelatedFindings, oscalTypes.RelatedFinding{FindingUuid: finding.UUID}, func(f oscalTypes.RelatedFinding) string { return f.FindingUuid })
+			if err := relateObservations(item, finding.RelatedObservations); err != nil {
+				return fmt.Errorf("finding %s: %w", finding.UUID, err)
+			}
+			if finding.RelatedRisks == nil {
+				continue
+			}
+			for _, relatedRisk := range *finding.RelatedRisks {
+				risk, ok := risksByUUID[relatedRisk.RiskUuid]
+				if !ok {
+					return fmt.Errorf("finding %s: risk %s not found", finding.UUID, relatedRisk.RiskUuid)
+				}
+				trackedRisks.Add(risk.UUID)
+				m.addRisk(risk)
+				item.RelatedRisks = appendUnique(item.RelatedRisks, relatedRisk, func(r oscalTypes.AssociatedRisk) string { return r.RiskUuid })
+				if err := relateObservations(item, risk.RelatedObservations); err != nil {
+					return fmt.Errorf("risk %s: %w", risk.UUID, err)
+				}
+			}
+		}
+	}
+
+	if result.Risks == nil {
+		return nil
+	}
+	for _, risk := range *result.Risks {
+		if risk.Status != riskStatusOpen || trackedRisks.Has(risk.UUID) {
+			continue
+		}
+		var ruleID string
+		if risk.Props != nil {
+			if prop, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *risk.Props); found {
+				ruleID = prop.Value
+			}
+		}
+		var item *oscalTypes.PoamItem
+		if ruleID != "" {
+			var err error
+			item, err = m.item(extensions.AssessmentRuleIdProp, ruleID, risk.Title, risk.Description,
+				fmt.Sprintf("Risk open in assessment result %s", result.UUID))
+			if err != nil {
+				return fmt.Errorf("risk %s: %w", risk.UUID, err)
+			}
+		} else {
+			// Risks without a rule cannot be tracked across results.
+			m.poam.PoamItems = append(m.poam.PoamItems, oscalTypes.PoamItem{
+				UUID:        m.identifiers.UUID("poam-item", "risk", risk.UUID),
+				Title:       risk.Title,
+				Description: risk.Description,
+			})
+			item = &m.poam.PoamItems[len(m.poam.PoamItems)-1]
+		}
+		m.addRisk(risk)
+		item.RelatedRisks = appendUnique(item.RelatedRisks, oscalTypes.AssociatedRisk{RiskUuid: risk.UUID}, func(r oscalTypes.AssociatedRisk) string { return r.RiskUuid })
+		if err := relateObservations(item, risk.RelatedObservations); err != nil {
+			return fmt.Errorf("risk %s: %w", risk.UUID, err)
+		}
+	}
+	return nil
+}
+
+// item returns the tracked item with the key property or adds a new item. The title and
+// description of tracked items are updated, and closed tracked items are reopened for the
+// reason.
+func (m *merger) item(keyProp, keyValue, title, description, reason string) (*oscalTypes.PoamItem, error) {
+	key := keyProp + "/" + keyValue
+	i, ok := m.itemsByKey[key]
+	if ok {
+		uuid := m.poam.PoamItems[i].UUID
+		status, err := m.manager.Status(uuid)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := m.reopened[uuid]; !found && status == poammanager.ItemStatusClosed {
+			m.reopened[uuid] = reason
+			m.reopenedOrder = append(m.reopenedOrder, uuid)
+		}
+	} else {
+		m.poam.PoamItems = append(m.poam.PoamItems, oscalTypes.PoamItem{
+			UUID: m.identifiers.UUID("poam-item", keyProp, keyValue),
+			Props: &[]oscalTypes.Property{
+				{Name: keyProp, Value: keyValue, Ns: extensions.TrestleNameSpace},
+			},
+		})
+		i = len(m.poam.PoamItems) - 1
+		m.itemsByKey[key] = i
+	}
+	item := &m.poam.PoamItems[i]
+	item.Title = title
+	item.Description = description
+	return item, nil
+}
+
+func (m *merger) addFinding(finding oscalTypes.Finding) {
+	if !m.findingIDs.Has(finding.UUID) {
+		m.findingIDs.Add(finding.UUID)
+		m.findings = append(m.findings, finding)
+	}
+}
+
+func (m *merger) addObservation(observation oscalTypes.Observation) {
+	if !m.observedIDs.Has(observation.UUID) {
+		m.observedIDs.Add(observation.UUID)
+		m.observations = append(m.observations, observation)
+	}
+}
+
+func (m *merger) addRisk(risk oscalTypes.Risk) {
+	if !m.riskIDs.Has(risk.UUID) {
+		m.riskIDs.Add(risk.UUID)
+		m.risks = append(m.risks, risk)
+	}
+}
+
+// apply sets the findings, observations and risks of the POA&M.
+func (m *merger) apply() {
+	m.poam.Findings = modelutils.NilIfEmpty(&m.findings)
+	m.poam.Observations = modelutils.NilIfEmpty(&m.observations)
+	m.poam.Risks = modelutils.NilIfEmpty(&m.risks)
+}
+
+// reopen reopens the closed items with new relations and their Risks. It is called after
+// apply, so the Risks of the POA&M are reopened.
+func (m *merger) reopen() error {
+	for _, uuid := range m.reopenedOrder {
+		if err := m.manager.Reopen(uuid, m.reopened[uuid]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// itemKey returns the key of the finding target or rule tracked by the item. It is empty
+// for items that are not tracked.
+func itemKey(item oscalTypes.PoamItem) string {
+	if item.Props == nil {
+		return ""
+	}
+	for _, keyProp := range []string{extensions.FindingTargetProp, extensions.AssessmentRuleIdProp} {
+		if prop, found := extensions.GetTrestleProp(keyProp, *item.Props); found {
+			return keyProp + "/" + prop.Value
+		}
+	}
+	return ""
+}
+
+// appendUnique appends the value to the slice unless a value with the same key exists.
+func appendUnique[T any](slice *[]T, value T, key func(T) string) *[]T {
+	if slice == nil {
+		return &[]T{value}
+	}
+	for _, existing := range *slice {
+		if key(existing) == key(value) {
+			return slice
+		}
+	}
+	*slice = append(*slice, value)
+	return slice
+}
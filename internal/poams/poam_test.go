@@ -0,0 +1,239 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	poammanager "github.com/oscal-compass/oscal-sdk-go/models/poams"
+)
+
+// testResults returns assessment results for a scan with a failed control ex-1 and a
+// failed rule rule-2 that is not mapped to a control. The UUIDs are prefixed with the scan.
+func testResults(scan string) oscalTypes.AssessmentResults {
+	ruleProp := func(ruleID string) *[]oscalTypes.Property {
+		return &[]oscalTypes.Property{{Name: extensions.AssessmentRuleIdProp, Value: ruleID, Ns: extensions.TrestleNameSpace}}
+	}
+	return oscalTypes.AssessmentResults{
+		UUID: scan + "-results",
+		Results: []oscalTypes.Result{
+			{
+				UUID: scan + "-result",
+				Observations: &[]oscalTypes.Observation{
+					{UUID: scan + "-observation-1", Title: "check-1", Methods: []string{"TEST"}},
+					{UUID: scan + "-observation-2", Title: "check-2", Methods: []string{"TEST"}},
+					{UUID: scan + "-observation-3", Title: "check-3", Methods: []string{"TEST"}},
+				},
+				Findings: &[]oscalTypes.Finding{
+					{
+						UUID:  scan + "-finding-1",
+						Title: `Finding For "ex-1"`,
+						Target: oscalTypes.FindingTarget{
+							Type: "objective-id", TargetId: "ex-1",
+							Status: oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "fail"},
+						},
+						RelatedObservations: &[]oscalTypes.RelatedObservation{{ObservationUuid: scan + "-observation-1"}},
+						RelatedRisks:        &[]oscalTypes.AssociatedRisk{{RiskUuid: scan + "-risk-1"}},
+					},
+					{
+						UUID:  scan + "-finding-2",
+						Title: `Finding For "ex-2"`,
+						Target: oscalTypes.FindingTarget{
+							Type: "objective-id", TargetId: "ex-2",
+							Status: oscalTypes.ObjectiveStatus{State: "satisfied", Reason: "pass"},
+						},
+						RelatedObservations: &[]oscalTypes.RelatedObservation{{ObservationUuid: scan + "-observation-3"}},
+					},
+				},
+				Risks: &[]oscalTypes.Risk{
+					{
+						UUID: scan + "-risk-1", Title: "Rule 1 description", Status: "open", Props: ruleProp("rule-1"),
+						RelatedObservations: &[]oscalTypes.RelatedObservation{{ObservationUuid: scan + "-observation-1"}},
+					},
+					{
+						UUID: scan + "-risk-2", Title: "Rule 2 description", Status: "open", Props: ruleProp("rule-2"),
+						RelatedObservations: &[]oscalTypes.RelatedObservation{{ObservationUuid: scan + "-observation-2"}},
+					},
+					{UUID: scan + "-risk-3", Title: "Rule 3 description", Status: "closed", Props: ruleProp("rule-3")},
+				},
+			},
+		},
+	}
+}
+
+func TestGeneratePOAM(t *testing.T) {
+	poam, err := GeneratePOAM(testResults("scan-1"), WithImport("ssp.json"), WithTitle("POA&M"))
+	require.NoError(t, err)
+	require.Equal(t, "POA&M", poam.Metadata.Title)
+	require.Equal(t, &oscalTypes.ImportSsp{Href: "ssp.json"}, poam.ImportSsp)
+
+	require.Len(t, poam.PoamItems, 2)
+	findingItem := poam.PoamItems[0]
+	require.Equal(t, `Finding For "ex-1"`, findingItem.Title)
+	require.Equal(t, &[]oscalTypes.Property{{Name: extensions.FindingTargetProp, Value: "ex-1", Ns: extensions.TrestleNameSpace}}, findingItem.Props)
+	require.Equal(t, &[]oscalTypes.RelatedFinding{{FindingUuid: "scan-1-finding-1"}}, findingItem.RelatedFindings)
+	require.Equal(t, &[]oscalTypes.RelatedObservation{{ObservationUuid: "scan-1-observation-1"}}, findingItem.RelatedObservations)
+	require.Equal(t, &[]oscalTypes.AssociatedRisk{{RiskUuid: "scan-1-risk-1"}}, findingItem.RelatedRisks)
+
+	riskItem := poam.PoamItems[1]
+	require.Equal(t, "Rule 2 description", riskItem.Title)
+	require.Equal(t, &[]oscalTypes.Property{{Name: extensions.AssessmentRuleIdProp, Value: "rule-2", Ns: extensions.TrestleNameSpace}}, riskItem.Props)
+	require.Nil(t, riskItem.RelatedFindings)
+	require.Equal(t, &[]oscalTypes.RelatedObservation{{ObservationUuid: "scan-1-observation-2"}}, riskItem.RelatedObservations)
+	require.Equal(t, &[]oscalTypes.AssociatedRisk{{RiskUuid: "scan-1-risk-2"}}, riskItem.RelatedRisks)
+
+	require.Len(t, *poam.Findings, 1)
+	require.Len(t, *poam.Observations, 2)
+	require.Len(t, *poam.Risks, 2)
+}
+
+func TestGeneratePOAM_Merge(t *testing.T) {
+	existing, err := GeneratePOAM(testResults("scan-1"), WithImport("ssp.json"))
+	require.NoError(t, err)
+	existingUUID := existing.UUID
+	itemUUIDs := []string{existing.PoamItems[0].UUID, existing.PoamItems[1].UUID}
+
+	poam, err := GeneratePOAM(testResults("scan-2"), WithImport("ssp.json"), WithPOAM(existing))
+	require.NoError(t, err)
+	require.Equal(t, existingUUID, poam.UUID)
+
+	// The tracked items are updated with the relations of the second scan.
+	require.Len(t, poam.PoamItems, 2)
+	require.Equal(t, itemUUIDs, []string{poam.PoamItems[0].UUID, poam.PoamItems[1].UUID})
+	require.Equal(t, &[]oscalTypes.RelatedFinding{{FindingUuid: "scan-1-finding-1"}, {FindingUuid: "scan-2-finding-1"}}, poam.PoamItems[0].RelatedFindings)
+	require.Equal(t, &[]oscalTypes.AssociatedRisk{{RiskUuid: "scan-1-risk-2"}, {RiskUuid: "scan-2-risk-2"}}, poam.PoamItems[1].RelatedRisks)
+	require.Len(t, *poam.Findings, 2)
+	require.Len(t, *poam.Observations, 4)
+	require.Len(t, *poam.Risks, 4)
+
+	// Merging the same results again changes nothing.
+	poam, err = GeneratePOAM(testResults("scan-2"), WithImport("ssp.json"), WithPOAM(poam))
+	require.NoError(t, err)
+	require.Len(t, poam.PoamItems, 2)
+	require.Len(t, *poam.PoamItems[0].RelatedFindings, 2)
+	require.Len(t, *poam.Findings, 2)
+}
+
+func TestGeneratePOAM_ReopenClosedItems(t *testing.T) {
+	poam, err := GeneratePOAM(testResults("scan-1"), WithImport("ssp.json"))
+	require.NoError(t, err)
+	itemUUID := poam.PoamItems[0].UUID
+
+	// The second scan satisfies ex-1, which closes its item and risk.
+	satisfied := testResults("scan-2")
+	(*satisfied.Results[0].Findings)[0].Target.Status = oscalTypes.ObjectiveStatus{State: "satisfied", Reason: "pass"}
+	manager := poammanager.NewManager(poam)
+	closed, err := manager.CloseSatisfied(satisfied)
+	require.NoError(t, err)
+	require.Equal(t, []string{itemUUID}, closed)
+	require.Equal(t, "closed", (*poam.Risks)[0].Status)
+
+	// The third scan fails ex-1 again, which reopens the item and its risk.
+	poam, err = GeneratePOAM(testResults("scan-3"), WithImport("ssp.json"), WithPOAM(poam))
+	require.NoError(t, err)
+	require.Len(t, poam.PoamItems, 2)
+	require.Equal(t, itemUUID, poam.PoamItems[0].UUID)
+	require.Equal(t, &[]oscalTypes.RelatedFinding{{FindingUuid: "scan-1-finding-1"}, {FindingUuid: "scan-3-finding-1"}}, poam.PoamItems[0].RelatedFindings)
+
+	manager = poammanager.NewManager(poam)
+	status, err := manager.Status(itemUUID)
+	require.NoError(t, err)
+	require.Equal(t, poammanager.ItemStatusOpen, status)
+	history, err := manager.History(itemUUID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, poammanager.ItemStatusClosed, history[0].Status)
+	require.Equal(t, poammanager.ItemStatusOpen, history[1].Status)
+	require.Equal(t, "Findings not satisfied in assessment result scan-3-result", history[1].Reason)
+	require.Equal(t, "scan-1-risk-1", (*poam.Risks)[0].UUID)
+	require.Equal(t, "open", (*poam.Risks)[0].Status)
+
+	// Open items are not reopened again.
+	poam, err = GeneratePOAM(testResults("scan-4"), WithImport("ssp.json"), WithPOAM(poam))
+	require.NoError(t, err)
+	history, err = poammanager.NewManager(poam).History(itemUUID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+}
+
+func TestGeneratePOAM_DeterministicUUIDs(t *testing.T) {
+	scanResults := func(scan string, scanned time.Time) oscalTypes.AssessmentResults {
+		results := testResults(scan)
+		results.Metadata.LastModified = scanned
+		return results
+	}
+	firstScan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondScan := firstScan.Add(24 * time.Hour)
+
+	first, err := GeneratePOAM(scanResults("scan-1", firstScan), WithDeterministicUUIDs("ssp.json"))
+	require.NoError(t, err)
+	second, err := GeneratePOAM(scanResults("scan-1", firstScan), WithDeterministicUUIDs("ssp.json"))
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, firstScan, first.Metadata.LastModified)
+
+	// Merges take the last-modified time from the results.
+	first, err = GeneratePOAM(scanResults("scan-2", secondScan), WithDeterministicUUIDs("ssp.json"), WithPOAM(first))
+	require.NoError(t, err)
+	second, err = GeneratePOAM(scanResults("scan-2", secondScan), WithDeterministicUUIDs("ssp.json"), WithPOAM(second))
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, secondScan, first.Metadata.LastModified)
+}
+
+func TestGeneratePOAM_Import(t *testing.T) {
+	poam, err := GeneratePOAM(testResults("scan-1"))
+	require.NoError(t, err)
+	require.Equal(t, &oscalTypes.ImportSsp{Href: models.SampleRequiredString}, poam.ImportSsp)
+
+	poam.ImportSsp = &oscalTypes.ImportSsp{Href: "ssp.json"}
+	poam, err = GeneratePOAM(testResults("scan-2"), WithPOAM(poam))
+	require.NoError(t, err)
+	require.Equal(t, &oscalTypes.ImportSsp{Href: "ssp.json"}, poam.ImportSsp)
+
+	poam, err = GeneratePOAM(testResults("scan-3"), WithPOAM(poam), WithImport("ssp-v2.json"))
+	require.NoError(t, err)
+	require.Equal(t, &oscalTypes.ImportSsp{Href: "ssp-v2.json"}, poam.ImportSsp)
+}
+
+func TestGeneratePOAM_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		results func() oscalTypes.AssessmentResults
+		wantErr string
+	}{
+		{
+			name: "Invalid/MissingObservation",
+			results: func() oscalTypes.AssessmentResults {
+				results := testResults("scan-1")
+				results.Results[0].Observations = nil
+				return results
+			},
+			wantErr: "result scan-1-result: finding scan-1-finding-1: observation scan-1-observation-1 not found",
+		},
+		{
+			name: "Invalid/MissingRisk",
+			results: func() oscalTypes.AssessmentResults {
+				results := testResults("scan-1")
+				results.Results[0].Risks = nil
+				return results
+			},
+			wantErr: "result scan-1-result: finding scan-1-finding-1: risk scan-1-risk-1 not found",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := GeneratePOAM(c.results())
+			require.EqualError(t, err, c.wantErr)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"errors"
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+// Finding target types for reviewed controls and control statements.
+const (
+	objectiveTargetType = "objective-id"
+	statementTargetType = "statement-id"
+)
+
+// Objective status states and reasons of findings.
+const (
+	stateSatisfied    = "satisfied"
+	stateNotSatisfied = "not-satisfied"
+	reasonPass        = "pass"
+	reasonFail        = "fail"
+	reasonOther       = "other"
+)
+
+// findingTarget identifies a reviewed control or control statement.
+type findingTarget struct {
+	targetType string
+	targetID   string
+	controlID  string
+}
+
+// findingsBuilder collects the observations of the rules mapped to the
+// reviewed controls of a result.
+type findingsBuilder struct {
+	targets        []findingTarget
+	observations   map[findingTarget][]oscalTypes.Observation
+	observationIDs map[findingTarget]set.Set[string]
+}
+
+func newFindingsBuilder() *findingsBuilder {
+	return &findingsBuilder{
+		observations:   make(map[findingTarget][]oscalTypes.Observation),
+		observationIDs: make(map[findingTarget]set.Set[string]),
+	}
+}
+
+// add relates the observations of a rule to the controls the rule is mapped to.
+func (f *findingsBuilder) add(controls []oscalTypes.AssessedControlsSelectControlById, observations []oscalTypes.Observation) {
+	for _, target := range findingTargets(controls) {
+		ids, ok := f.observationIDs[target]
+		if !ok {
+			ids = set.New[string]()
+			f.observationIDs[target] = ids
+			f.targets = append(f.targets, target)
+		}
+		for _, observation := range observations {
+			if ids.Has(observation.UUID) {
+				continue
+			}
+			ids.Add(observation.UUID)
+			f.observations[target] = append(f.observations[target], observation)
+		}
+	}
+}
+
+// findingTargets returns the finding targets for controls. Controls with statements are
+// targeted by statement.
+func findingTargets(controls []oscalTypes.AssessedControlsSelectControlById) []findingTarget {
+	var targets []findingTarget
+	for _, control := range controls {
+		if control.StatementIds == nil || len(*control.StatementIds) == 0 {
+			targets = append(targets, findingTarget{targetType: objectiveTargetType, targetID: control.ControlId, controlID: control.ControlId})
+			continue
+		}
+		for _, statementID := range *control.StatementIds {
+			targets = append(targets, findingTarget{targetType: statementTargetType, targetID: statementID, controlID: control.ControlId})
+		}
+	}
+	return targets
+}
+
+// findings returns one finding per reviewed control or control statement in review order.
+func (f *findingsBuilder) findings(resultUUID string, ids identifiers.Generator) []oscalTypes.Finding {
+	findings := make([]oscalTypes.Finding, 0, len(f.targets))
+	for _, target := range f.targets {
+		observations := f.observations[target]
+		var relatedObservations []oscalTypes.RelatedObservation
+		for _, observation := range observations {
+			relatedObservations = append(relatedObservations, oscalTypes.RelatedObservation{ObservationUuid: observation.UUID})
+		}
+
+		description := fmt.Sprintf("OSCAL Assessment Finding For Control %q", target.controlID)
+		if target.targetType == statementTargetType {
+			description = fmt.Sprintf("OSCAL Assessment Finding For Statement %q Of Control %q", target.targetID, target.controlID)
+		}
+		findings = append(findings, oscalTypes.Finding{
+			UUID:        ids.UUID("finding", resultUUID, target.targetType, target.targetID),
+			Title:       fmt.Sprintf("Finding For %q", target.targetID),
+			Description: description,
+			Target: oscalTypes.FindingTarget{
+				Type:     target.targetType,
+				TargetId: target.targetID,
+				Status:   objectiveStatus(observations),
+			},
+			RelatedObservations: modelutils.NilIfEmpty(&relatedObservations),
+		})
+	}
+	return findings
+}
+
+// objectiveStatus returns the status of a reviewed control from the results of the related
+// observations. The control is satisfied if all observations passed or do not apply, and at
+// least one observation passed. Observations without any check result, e.g. from tools that
+// do not record check outcomes, are taken as satisfied.
+func objectiveStatus(observations []oscalTypes.Observation) oscalTypes.ObjectiveStatus {
+	if len(observations) == 0 {
+		return oscalTypes.ObjectiveStatus{
+			State:   stateNotSatisfied,
+			Reason:  reasonOther,
+			Remarks: "No related observations.",
+		}
+	}
+	var results []extensions.CheckResult
+	complete := true
+	for _, observation := range observations {
+		result := observationResult(observation)
+		if result == "" {
+			complete = false
+		}
+		results = append(results, result)
+	}
+	switch aggregate := extensions.AggregateCheckResults(results...); {
+	case aggregate == "":
+		return oscalTypes.ObjectiveStatus{
+			State:   stateSatisfied,
+			Remarks: "No related observation has a check result.",
+		}
+	case aggregate == extensions.CheckResultFail:
+		return oscalTypes.ObjectiveStatus{State: stateNotSatisfied, Reason: reasonFail}
+	case aggregate == extensions.CheckResultError:
+		return oscalTypes.ObjectiveStatus{
+			State:   stateNotSatisfied,
+			Reason:  reasonOther,
+			Remarks: "Not all related checks could be completed.",
+		}
+	case !complete:
+		return oscalTypes.ObjectiveStatus{
+			State:   stateNotSatisfied,
+			Reason:  reasonOther,
+			Remarks: "Not all related observations have a check result.",
+		}
+	case aggregate == extensions.CheckResultNotApplicable:
+		return oscalTypes.ObjectiveStatus{
+			State:   stateNotSatisfied,
+			Reason:  reasonOther,
+			Remarks: "None of the related checks apply.",
+		}
+	default:
+		return oscalTypes.ObjectiveStatus{State: stateSatisfied, Reason: reasonPass}
+	}
+}
+
+// observationResult returns the aggregated result of the checks on the observation subjects.
+// Subjects with an invalid check result count as errors. The result is empty if no subject
+// has a check result.
+func observationResult(observation oscalTypes.Observation) extensions.CheckResult {
+	if observation.Subjects == nil {
+		return ""
+	}
+	var results []extensions.CheckResult
+	for _, subject := range *observation.Subjects {
+		results = append(results, subjectResult(subject))
+	}
+	return extensions.AggregateCheckResults(results...)
+}
+
+// subjectResult returns the check result of an observation subject.
+func subjectResult(subject oscalTypes.SubjectReference) extensions.CheckResult {
+	outcome, err := extensions.GetCheckOutcome(subject)
+	switch {
+	case errors.Is(err, extensions.ErrCheckOutcomeNotFound):
+		return ""
+	case err != nil:
+		return extensions.CheckResultError
+	}
+	return outcome.Result
+}
+
+// ruleResults returns the aggregated result of the checks of a rule on all subjects,
+// followed by the aggregated results per subject in subject order. It is empty if no
+// observation subject has a check result.
+func ruleResults(ruleID string, observations []oscalTypes.Observation) []extensions.RuleResult {
+	var subjects []string
+	bySubject := make(map[string][]extensions.CheckResult)
+	for _, observation := range observations {
+		if observation.Subjects == nil {
+			continue
+		}
+		for _, subject := range *observation.Subjects {
+			result := subjectResult(subject)
+			if result == "" {
+				continue
+			}
+			if _, ok := bySubject[subject.SubjectUuid]; !ok {
+				subjects = append(subjects, subject.SubjectUuid)
+			}
+			bySubject[subject.SubjectUuid] = append(bySubject[subject.SubjectUuid], result)
+		}
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+
+	subjectResults := make([]extensions.RuleResult, 0, len(subjects))
+	var aggregates []extensions.CheckResult
+	for _, subjectUUID := range subjects {
+		aggregate := extensions.AggregateCheckResults(bySubject[subjectUUID]...)
+		aggregates = append(aggregates, aggregate)
+		subjectResults = append(subjectResults, extensions.RuleResult{RuleID: ruleID, SubjectUUID: subjectUUID, Result: aggregate})
+	}
+	ruleResult := extensions.RuleResult{RuleID: ruleID, Result: extensions.AggregateCheckResults(aggregates...)}
+	return append([]extensions.RuleResult{ruleResult}, subjectResults...)
+}
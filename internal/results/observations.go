@@ -8,10 +8,10 @@ package results
 import (
 	"time"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
 )
 
 // observationsManager indexes and manages OSCAL Observations
@@ -19,15 +19,18 @@ import (
 type observationsManager struct {
 	observationsByCheck map[string]oscalTypes.Observation
 	actorsByCheck       map[string]string
+	identifiers         identifiers.Generator
 }
 
 // newObservationManager creates an observationManager struct loaded with
-// actor information from the Assessment Plan Assessment Assets.
-func newObservationManager(plan oscalTypes.AssessmentPlan) *observationsManager {
+// actor information from the Assessment Plan Assessment Assets. New Observation
+// UUIDs are created with the given Generator.
+func newObservationManager(plan oscalTypes.AssessmentPlan, ids identifiers.Generator) *observationsManager {
 	// Index validation components to set the Actor information
 	m := &observationsManager{
 		observationsByCheck: make(map[string]oscalTypes.Observation),
 		actorsByCheck:       make(map[string]string),
+		identifiers:         ids,
 	}
 	if plan.AssessmentAssets != nil && plan.AssessmentAssets.Components != nil {
 		for _, comp := range *plan.AssessmentAssets.Components {
@@ -50,8 +53,9 @@ func (o *observationsManager) load(observations []oscalTypes.Observation) {
 	}
 }
 
-// createOrGet return an existing observation or a newly created one.
-func (o *observationsManager) createOrGet(checkId string) oscalTypes.Observation {
+// createOrGet return an existing observation or a newly created one. The UUID of
+// a new observation is derived from the result it is created for and the check.
+func (o *observationsManager) createOrGet(resultUUID, checkId string) oscalTypes.Observation {
 	for _, observation := range o.observationsByCheck {
 		// Loop through the Props slice to find the AssessmentCheckIdProp
 		if observation.Props == nil {
@@ -71,7 +75,7 @@ func (o *observationsManager) createOrGet(checkId string) oscalTypes.Observation
 	}
 
 	emptyObservation := oscalTypes.Observation{
-		UUID:      uuid.NewUUID(),
+		UUID:      o.identifiers.UUID("observation", resultUUID, checkId),
 		Title:     checkId,
 		Collected: time.Now(),
 	}
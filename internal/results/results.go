@@ -7,13 +7,16 @@ package results
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
 	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
 )
 
 const defaultActor = "tool"
@@ -22,6 +25,10 @@ type generateOpts struct {
 	title        string
 	importAP     string
 	observations []oscalTypes.Observation
+	identifiers  identifiers.Generator
+	settings     *settings.ImplementationSettings
+	risks        bool
+	riskFacets   RiskFacetsFunc
 }
 
 func (g *generateOpts) defaults() {
@@ -57,12 +64,61 @@ func WithObservations(observations []oscalTypes.Observation) GenerateOption {
 	}
 }
 
+// WithDeterministicUUIDs is a GenerateOption that derives name-based (version 5)
+// UUIDs from stable input keys, such as the task UUID for results and the check ID
+// for observations, so generating results from the same plan produces the same UUIDs.
+// The seed distinguishes results generated from the same inputs and may be empty.
+// The start and collected times of results and observations are not deterministic.
+func WithDeterministicUUIDs(seed string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.identifiers = identifiers.NewDeterministic(seed)
+	}
+}
+
+// WithImplementationSettings is a GenerateOption that maps the rules of the Assessment Plan
+// Activities to controls with ImplementationSettings.ApplicableControls to generate findings.
+// By default, the related controls of the Activities are used.
+func WithImplementationSettings(implementationSettings *settings.ImplementationSettings) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.settings = implementationSettings
+	}
+}
+
+// WithRisks is a GenerateOption that adds an open Risk for each rule with failed checks or
+// checks that could not be completed.
+// The Risk title and description are the rule description from the Assessment Plan Activity.
+// Each Risk links to the not-satisfied Findings of the controls the rule is mapped to, and
+// these Findings relate to the Risk.
+func WithRisks() GenerateOption {
+	return func(opts *generateOpts) {
+		opts.risks = true
+	}
+}
+
+// WithRiskFacets is a GenerateOption that characterizes the Risks added by WithRisks with
+// the facets of the rule, such as its severity or likelihood. The characterization origin is
+// the origin of the Observations that raised the Risk. Risks of rules without such an
+// Observation origin are not characterized.
+func WithRiskFacets(facets RiskFacetsFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.riskFacets = facets
+	}
+}
+
 // GenerateAssessmentResults generates an AssessmentPlan for a set of Components and ImplementationSettings. The chosen inputs allow an Assessment Plan to be generated from
 // a set of OSCAL ComponentDefinitions or a SystemSecurityPlan.
 //
 // If `WithImport` is not set, all input components are set as Components in the Local Definitions.
 // If `WithObservations is not set, default behavior is to create a new, empty Observation for each activity step with the step.Title as the
 // Observation title.
+//
+// Each Result has one Finding per reviewed control, or per control statement if the control
+// selection includes statements. A Finding is satisfied if all Observations of the rules mapped
+// to the control passed, and links to these Observations.
+//
+// Check outcomes on Observation subjects are read with extensions.GetCheckOutcome. Each Result
+// has the aggregated extensions.RuleResult of each rule and of each rule per subject in its
+// properties, which can be read with extensions.FindRuleResults.
 func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateOption) (*oscalTypes.AssessmentResults, error) {
 	options := generateOpts{}
 	options.defaults()
@@ -74,7 +130,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 	metadata.Title = options.title
 
 	assessmentResults := &oscalTypes.AssessmentResults{
-		UUID: uuid.NewUUID(),
+		UUID: options.identifiers.UUID("assessment-results", options.title, options.importAP, plan.UUID),
 		ImportAp: oscalTypes.ImportAp{
 			Href: options.importAP,
 		},
@@ -87,7 +143,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 	}
 	tasks := *plan.Tasks
 
-	observationManager := newObservationManager(plan)
+	observationManager := newObservationManager(plan, options.identifiers)
 	if options.observations != nil {
 		observationManager.load(options.observations)
 	}
@@ -105,7 +161,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 			Title:       fmt.Sprintf("Result For Task %q", task.Title),
 			Description: fmt.Sprintf("OSCAL Assessment Result For Task %q", task.Title),
 			Start:       time.Now(),
-			UUID:        uuid.NewUUID(),
+			UUID:        options.identifiers.UUID("result", task.UUID),
 		}
 
 		// Some initial checks before proceeding with the rest
@@ -118,8 +174,12 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 		// checks.
 		var reviewedControls oscalTypes.ReviewedControls
 		var associatedObservations []oscalTypes.Observation
+		findings := newFindingsBuilder()
+		var checkResults []extensions.RuleResult
+		var assessedRules []assessedRule
 		for _, assocActivity := range *task.AssociatedActivities {
 			activity := activitiesByUUID[assocActivity.ActivityUuid]
+			var activityObservations []oscalTypes.Observation
 
 			if activity.RelatedControls != nil {
 				reviewedControls.ControlSelections = append(reviewedControls.ControlSelections, activity.RelatedControls.ControlSelections...)
@@ -143,7 +203,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 				// One Observation per Activity Step
 				// Observation Title == Check
 				for _, step := range *activity.Steps {
-					observation := observationManager.createOrGet(step.Title)
+					observation := observationManager.createOrGet(result.UUID, step.Title)
 					for _, method := range methods {
 						observation.Methods = append(observation.Methods, method.Value)
 					}
@@ -167,16 +227,52 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 						*origin[0].RelatedTasks = append(*origin[0].RelatedTasks, relatedTask)
 					}
 					associatedObservations = append(associatedObservations, observation)
+					activityObservations = append(activityObservations, observation)
 				}
 			}
+			controls := options.controlsForRule(activity)
+			findings.add(controls, activityObservations)
+			assessedRules = append(assessedRules, assessedRule{activity: activity, observations: activityObservations, controls: controls})
+			checkResults = append(checkResults, ruleResults(activity.Title, activityObservations)...)
 		}
 
 		result.ReviewedControls = reviewedControls
 		if len(associatedObservations) > 0 {
 			result.Observations = &associatedObservations
 		}
+		resultFindings := findings.findings(result.UUID, options.identifiers)
+		if options.risks {
+			risks := risksBuilder{facets: options.riskFacets, ids: options.identifiers}.risks(result.UUID, assessedRules, resultFindings)
+			result.Risks = modelutils.NilIfEmpty(&risks)
+		}
+		result.Findings = modelutils.NilIfEmpty(&resultFindings)
+		resultProps := extensions.RuleResultProps(checkResults)
+		result.Props = modelutils.NilIfEmpty(&resultProps)
 		assessmentResults.Results = append(assessmentResults.Results, result)
 	}
 
 	return assessmentResults, nil
 }
+
+// controlsForRule returns the controls mapped to the rule of the Activity.
+func (g *generateOpts) controlsForRule(activity oscalTypes.Activity) []oscalTypes.AssessedControlsSelectControlById {
+	if g.settings != nil {
+		// Rules that are not in the settings are not mapped to controls.
+		controls, _ := g.settings.ApplicableControls(activity.Title)
+		sort.Slice(controls, func(i, j int) bool {
+			return controls[i].ControlId < controls[j].ControlId
+		})
+		return controls
+	}
+
+	var controls []oscalTypes.AssessedControlsSelectControlById
+	if activity.RelatedControls == nil {
+		return controls
+	}
+	for _, selection := range activity.RelatedControls.ControlSelections {
+		if selection.IncludeControls != nil {
+			controls = append(controls, *selection.IncludeControls...)
+		}
+	}
+	return controls
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
 	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
 	"github.com/oscal-compass/oscal-sdk-go/validation"
 )
 
@@ -352,3 +354,399 @@ func TestGenerateAssessmentResults(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateAssessmentResults_DeterministicUUIDs(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	generate := func(opts ...GenerateOption) []string {
+		results, err := GenerateAssessmentResults(*plan, opts...)
+		require.NoError(t, err)
+		uuids := []string{results.UUID}
+		for _, result := range results.Results {
+			uuids = append(uuids, result.UUID)
+			require.NotNil(t, result.Observations)
+			for _, observation := range *result.Observations {
+				uuids = append(uuids, observation.UUID)
+			}
+		}
+		return uuids
+	}
+
+	first := generate(WithDeterministicUUIDs(""))
+	require.Len(t, first, 3)
+	require.Equal(t, first, generate(WithDeterministicUUIDs("")))
+	require.NotEqual(t, first, generate(WithDeterministicUUIDs("other")))
+	require.NotEqual(t, first, generate())
+
+	// Observations of the same check in another task get another UUID
+	(*plan.Tasks)[0].UUID = "a8d3b5c1-2f4e-4b6a-9c7d-1e2f3a4b5c6d"
+	other := generate(WithDeterministicUUIDs(""))
+	require.Equal(t, first[0], other[0])
+	require.NotEqual(t, first[2], other[2])
+}
+
+func TestGenerateAssessmentResults_Findings(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	checkObservation := func(result extensions.CheckResult) oscalTypes.Observation {
+		return oscalTypes.Observation{
+			UUID:  "observation-1",
+			Title: "check-1",
+			Subjects: &[]oscalTypes.SubjectReference{
+				{
+					SubjectUuid: "4e19131e-b361-4f0e-8262-02bf4456202e",
+					Type:        "component",
+					Props: &[]oscalTypes.Property{
+						{Name: extensions.AssessmentResultProp, Value: string(result), Ns: extensions.TrestleNameSpace},
+					},
+				},
+			},
+		}
+	}
+	ex3Settings := settings.NewImplementationSettings(components.NewControlImplementationSetAdapter(oscalTypes.ControlImplementationSet{
+		ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+			{
+				ControlId: "ex-3",
+				Props: &[]oscalTypes.Property{
+					{Name: extensions.RuleIdProp, Value: "rule-1", Ns: extensions.TrestleNameSpace},
+				},
+			},
+		},
+	}))
+
+	type wantFinding struct {
+		targetID     string
+		status       oscalTypes.ObjectiveStatus
+		observations int
+	}
+	satisfied := oscalTypes.ObjectiveStatus{State: "satisfied", Reason: "pass"}
+	notSatisfied := oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "fail"}
+	tests := []struct {
+		name         string
+		inputOptions []GenerateOption
+		wantFindings []wantFinding
+	}{
+		{
+			name: "Success/Passed",
+			inputOptions: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{checkObservation(extensions.CheckResultPass)}),
+			},
+			wantFindings: []wantFinding{
+				{targetID: "ex-2", status: satisfied, observations: 1},
+				{targetID: "ex-1", status: satisfied, observations: 1},
+			},
+		},
+		{
+			name: "Success/Failed",
+			inputOptions: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{checkObservation(extensions.CheckResultFail)}),
+			},
+			wantFindings: []wantFinding{
+				{targetID: "ex-2", status: notSatisfied, observations: 1},
+				{targetID: "ex-1", status: notSatisfied, observations: 1},
+			},
+		},
+		{
+			name: "Success/NoCheckResults",
+			wantFindings: []wantFinding{
+				{
+					targetID:     "ex-2",
+					status:       oscalTypes.ObjectiveStatus{State: "satisfied", Remarks: "No related observation has a check result."},
+					observations: 1,
+				},
+				{
+					targetID:     "ex-1",
+					status:       oscalTypes.ObjectiveStatus{State: "satisfied", Remarks: "No related observation has a check result."},
+					observations: 1,
+				},
+			},
+		},
+		{
+			name: "Success/WithImplementationSettings",
+			inputOptions: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{checkObservation(extensions.CheckResultPass)}),
+				WithImplementationSettings(ex3Settings),
+			},
+			wantFindings: []wantFinding{
+				{targetID: "ex-3", status: satisfied, observations: 1},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			results, err := GenerateAssessmentResults(*plan, c.inputOptions...)
+			require.NoError(t, err)
+			require.Len(t, results.Results, 1)
+			result := results.Results[0]
+			require.NotNil(t, result.Findings)
+			require.NotNil(t, result.Observations)
+			observationUUID := (*result.Observations)[0].UUID
+
+			var gotFindings []wantFinding
+			for _, finding := range *result.Findings {
+				require.Equal(t, "objective-id", finding.Target.Type)
+				require.NotNil(t, finding.RelatedObservations)
+				for _, related := range *finding.RelatedObservations {
+					require.Equal(t, observationUUID, related.ObservationUuid)
+				}
+				gotFindings = append(gotFindings, wantFinding{
+					targetID:     finding.Target.TargetId,
+					status:       finding.Target.Status,
+					observations: len(*finding.RelatedObservations),
+				})
+			}
+			require.Equal(t, c.wantFindings, gotFindings)
+		})
+	}
+}
+
+func TestGenerateAssessmentResults_RuleResults(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	subject := func(uuid string, outcome *extensions.CheckOutcome) oscalTypes.SubjectReference {
+		subject := oscalTypes.SubjectReference{SubjectUuid: uuid, Type: "component"}
+		if outcome != nil {
+			require.NoError(t, extensions.SetCheckOutcome(&subject, *outcome))
+		}
+		return subject
+	}
+	observation := func(subjects ...oscalTypes.SubjectReference) []oscalTypes.Observation {
+		return []oscalTypes.Observation{{UUID: "observation-1", Title: "check-1", Subjects: &subjects}}
+	}
+
+	tests := []struct {
+		name            string
+		observations    []oscalTypes.Observation
+		wantRuleResults []extensions.RuleResult
+		wantStatus      oscalTypes.ObjectiveStatus
+	}{
+		{
+			name: "Success/FailedSubject",
+			observations: observation(
+				subject("subject-1", &extensions.CheckOutcome{Result: extensions.CheckResultPass}),
+				subject("subject-2", &extensions.CheckOutcome{Result: extensions.CheckResultFail, Reason: "file mode is 0644"}),
+			),
+			wantRuleResults: []extensions.RuleResult{
+				{RuleID: "rule-1", Result: extensions.CheckResultFail},
+				{RuleID: "rule-1", SubjectUUID: "subject-1", Result: extensions.CheckResultPass},
+				{RuleID: "rule-1", SubjectUUID: "subject-2", Result: extensions.CheckResultFail},
+			},
+			wantStatus: oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "fail"},
+		},
+		{
+			name: "Success/ErroredSubject",
+			observations: observation(
+				subject("subject-1", &extensions.CheckOutcome{Result: extensions.CheckResultPass}),
+				subject("subject-2", &extensions.CheckOutcome{Result: extensions.CheckResultError}),
+			),
+			wantRuleResults: []extensions.RuleResult{
+				{RuleID: "rule-1", Result: extensions.CheckResultError},
+				{RuleID: "rule-1", SubjectUUID: "subject-1", Result: extensions.CheckResultPass},
+				{RuleID: "rule-1", SubjectUUID: "subject-2", Result: extensions.CheckResultError},
+			},
+			wantStatus: oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "other", Remarks: "Not all related checks could be completed."},
+		},
+		{
+			name: "Success/NotApplicableSubject",
+			observations: observation(
+				subject("subject-1", &extensions.CheckOutcome{Result: extensions.CheckResultPass}),
+				subject("subject-2", &extensions.CheckOutcome{Result: extensions.CheckResultNotApplicable}),
+				subject("subject-3", nil),
+			),
+			wantRuleResults: []extensions.RuleResult{
+				{RuleID: "rule-1", Result: extensions.CheckResultPass},
+				{RuleID: "rule-1", SubjectUUID: "subject-1", Result: extensions.CheckResultPass},
+				{RuleID: "rule-1", SubjectUUID: "subject-2", Result: extensions.CheckResultNotApplicable},
+			},
+			wantStatus: oscalTypes.ObjectiveStatus{State: "satisfied", Reason: "pass"},
+		},
+		{
+			name: "Success/NotApplicable",
+			observations: observation(
+				subject("subject-1", &extensions.CheckOutcome{Result: extensions.CheckResultNotApplicable}),
+			),
+			wantRuleResults: []extensions.RuleResult{
+				{RuleID: "rule-1", Result: extensions.CheckResultNotApplicable},
+				{RuleID: "rule-1", SubjectUUID: "subject-1", Result: extensions.CheckResultNotApplicable},
+			},
+			wantStatus: oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "other", Remarks: "None of the related checks apply."},
+		},
+		{
+			name:         "Success/NoCheckResults",
+			observations: observation(subject("subject-1", nil)),
+			wantStatus:   oscalTypes.ObjectiveStatus{State: "satisfied", Remarks: "No related observation has a check result."},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			results, err := GenerateAssessmentResults(*plan, WithObservations(c.observations))
+			require.NoError(t, err)
+			require.Len(t, results.Results, 1)
+			result := results.Results[0]
+			if c.wantRuleResults == nil {
+				require.Nil(t, result.Props)
+			} else {
+				require.NotNil(t, result.Props)
+				require.Equal(t, c.wantRuleResults, extensions.FindRuleResults(*result.Props))
+			}
+			require.NotNil(t, result.Findings)
+			for _, finding := range *result.Findings {
+				require.Equal(t, c.wantStatus, finding.Target.Status)
+			}
+		})
+	}
+}
+
+func TestGenerateAssessmentResults_Risks(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	checkObservation := func(result extensions.CheckResult) []oscalTypes.Observation {
+		subject := oscalTypes.SubjectReference{SubjectUuid: "subject-1", Type: "component"}
+		require.NoError(t, extensions.SetCheckOutcome(&subject, extensions.CheckOutcome{Result: result}))
+		return []oscalTypes.Observation{{UUID: "observation-1", Title: "check-1", Subjects: &[]oscalTypes.SubjectReference{subject}}}
+	}
+	severity := []oscalTypes.Facet{{Name: "severity", System: "https://example.com/severity", Value: "high"}}
+	facets := func(ruleID string) []oscalTypes.Facet {
+		if ruleID == "rule-1" {
+			return severity
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		inputOptions  []GenerateOption
+		withoutActors bool
+		wantStatement string
+		wantFacets    []oscalTypes.Facet
+	}{
+		{
+			name: "Success/FailedCheckWithoutRisks",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultFail)),
+			},
+		},
+		{
+			name: "Success/PassedCheck",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultPass)),
+				WithRisks(),
+			},
+		},
+		{
+			name: "Success/FailedCheck",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultFail)),
+				WithRisks(),
+			},
+			wantStatement: `Checks of rule "rule-1" failed in 1 observation(s).`,
+		},
+		{
+			name: "Success/FailedCheckWithFacets",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultFail)),
+				WithRisks(),
+				WithRiskFacets(facets),
+			},
+			wantStatement: `Checks of rule "rule-1" failed in 1 observation(s).`,
+			wantFacets:    severity,
+		},
+		{
+			name: "Success/FailedCheckWithoutOriginOrFacets",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultFail)),
+				WithRisks(),
+			},
+			withoutActors: true,
+			wantStatement: `Checks of rule "rule-1" failed in 1 observation(s).`,
+		},
+		{
+			// Risks are not characterized without an origin.
+			name: "Success/FailedCheckWithFacetsWithoutOrigin",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultFail)),
+				WithRisks(),
+				WithRiskFacets(facets),
+			},
+			withoutActors: true,
+			wantStatement: `Checks of rule "rule-1" failed in 1 observation(s).`,
+		},
+		{
+			name: "Success/ErroredCheck",
+			inputOptions: []GenerateOption{
+				WithObservations(checkObservation(extensions.CheckResultError)),
+				WithRisks(),
+				WithRiskFacets(facets),
+			},
+			wantStatement: `Checks of rule "rule-1" could not be completed in 1 observation(s).`,
+			wantFacets:    severity,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			testPlan := *plan
+			if c.withoutActors {
+				// Observations get their origin from the validation components of the plan.
+				testPlan.AssessmentAssets = nil
+			}
+			results, err := GenerateAssessmentResults(testPlan, c.inputOptions...)
+			require.NoError(t, err)
+			require.Len(t, results.Results, 1)
+			result := results.Results[0]
+			require.NotNil(t, result.Findings)
+			if c.wantStatement == "" {
+				require.Nil(t, result.Risks)
+				for _, finding := range *result.Findings {
+					require.Nil(t, finding.RelatedRisks)
+				}
+				return
+			}
+
+			require.NotNil(t, result.Risks)
+			require.Len(t, *result.Risks, 1)
+			risk := (*result.Risks)[0]
+			require.Equal(t, "Rule 1 description", risk.Title)
+			require.Equal(t, "Rule 1 description", risk.Description)
+			require.Equal(t, "open", risk.Status)
+			require.Equal(t, c.wantStatement, risk.Statement)
+			require.Equal(t, &[]oscalTypes.RelatedObservation{{ObservationUuid: "observation-1"}}, risk.RelatedObservations)
+			ruleID, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *risk.Props)
+			require.True(t, found)
+			require.Equal(t, "rule-1", ruleID.Value)
+
+			// Both controls of rule-1 are not satisfied.
+			require.Len(t, *result.Findings, 2)
+			require.NotNil(t, risk.Links)
+			require.Len(t, *risk.Links, 2)
+			for i, finding := range *result.Findings {
+				require.Equal(t, &[]oscalTypes.AssociatedRisk{{RiskUuid: risk.UUID}}, finding.RelatedRisks)
+				require.Equal(t, oscalTypes.Link{Href: "#" + finding.UUID, Rel: "finding"}, (*risk.Links)[i])
+			}
+
+			if c.wantFacets == nil {
+				require.Nil(t, risk.Characterizations)
+				return
+			}
+			require.NotNil(t, risk.Characterizations)
+			characterization := (*risk.Characterizations)[0]
+			require.Equal(t, c.wantFacets, characterization.Facets)
+			require.NotEmpty(t, characterization.Origin.Actors)
+		})
+	}
+}
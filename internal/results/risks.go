@@ -0,0 +1,146 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"fmt"
+	"slices"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+const (
+	// riskStatusOpen is the status of new risks.
+	riskStatusOpen = "open"
+	// findingLinkRel is the relation of the link from a risk to the finding that caused it.
+	findingLinkRel = "finding"
+)
+
+// RiskFacetsFunc returns the facets that characterize the risk of a failed rule, such
+// as its severity or likelihood. It returns no facets for rules without a characterization.
+type RiskFacetsFunc func(ruleID string) []oscalTypes.Facet
+
+// assessedRule is a rule of an Assessment Plan Activity with the observations of its checks
+// and the controls it is mapped to.
+type assessedRule struct {
+	activity     oscalTypes.Activity
+	observations []oscalTypes.Observation
+	controls     []oscalTypes.AssessedControlsSelectControlById
+}
+
+// risksBuilder creates risks for rules with failed checks or checks that could not be completed.
+type risksBuilder struct {
+	facets RiskFacetsFunc
+	ids    identifiers.Generator
+}
+
+// risks returns one open risk per rule with failed checks or checks that could not be
+// completed, and relates the risk to the not-satisfied findings of the controls the rule
+// is mapped to.
+func (r risksBuilder) risks(resultUUID string, rules []assessedRule, findings []oscalTypes.Finding) []oscalTypes.Risk {
+	findingsByTarget := make(map[findingTarget]*oscalTypes.Finding)
+	for i := range findings {
+		target := findingTarget{targetType: findings[i].Target.Type, targetID: findings[i].Target.TargetId}
+		findingsByTarget[target] = &findings[i]
+	}
+
+	var risks []oscalTypes.Risk
+	for _, rule := range rules {
+		var failed, errored []oscalTypes.Observation
+		for _, observation := range rule.observations {
+			switch observationResult(observation) {
+			case extensions.CheckResultFail:
+				failed = append(failed, observation)
+			case extensions.CheckResultError:
+				errored = append(errored, observation)
+			}
+		}
+		if len(failed) == 0 && len(errored) == 0 {
+			continue
+		}
+
+		risk := r.newRisk(resultUUID, rule.activity, failed, errored)
+		var links []oscalTypes.Link
+		for _, target := range findingTargets(rule.controls) {
+			finding, ok := findingsByTarget[findingTarget{targetType: target.targetType, targetID: target.targetID}]
+			if !ok || finding.Target.Status.State != stateNotSatisfied {
+				continue
+			}
+			if finding.RelatedRisks == nil {
+				finding.RelatedRisks = &[]oscalTypes.AssociatedRisk{}
+			}
+			*finding.RelatedRisks = append(*finding.RelatedRisks, oscalTypes.AssociatedRisk{RiskUuid: risk.UUID})
+			links = append(links, oscalTypes.Link{Href: "#" + finding.UUID, Rel: findingLinkRel})
+		}
+		risk.Links = modelutils.NilIfEmpty(&links)
+		risks = append(risks, risk)
+	}
+	return risks
+}
+
+// newRisk returns an open risk for a rule with the failed observations of its checks and the
+// observations of its checks that could not be completed. The risk is characterized by the
+// facets of the rule from the origin of the first of these observations with an origin,
+// because a characterization requires an origin. Without an origin, the risk is not
+// characterized.
+func (r risksBuilder) newRisk(resultUUID string, activity oscalTypes.Activity, failed, errored []oscalTypes.Observation) oscalTypes.Risk {
+	ruleID := activity.Title
+	description := activity.Description
+	if description == "" {
+		description = fmt.Sprintf("Rule %q", ruleID)
+	}
+
+	var relatedObservations []oscalTypes.RelatedObservation
+	var origins []oscalTypes.Origin
+	for _, observation := range append(slices.Clip(failed), errored...) {
+		relatedObservations = append(relatedObservations, oscalTypes.RelatedObservation{ObservationUuid: observation.UUID})
+		if observation.Origins != nil {
+			origins = append(origins, *observation.Origins...)
+		}
+	}
+
+	var statement string
+	switch {
+	case len(errored) == 0:
+		statement = fmt.Sprintf("Checks of rule %q failed in %d observation(s).", ruleID, len(failed))
+	case len(failed) == 0:
+		statement = fmt.Sprintf("Checks of rule %q could not be completed in %d observation(s).", ruleID, len(errored))
+	default:
+		statement = fmt.Sprintf("Checks of rule %q failed in %d observation(s) and could not be completed in %d observation(s).", ruleID, len(failed), len(errored))
+	}
+
+	risk := oscalTypes.Risk{
+		UUID:                r.ids.UUID("risk", resultUUID, ruleID),
+		Title:               description,
+		Description:         description,
+		Statement:           statement,
+		Status:              riskStatusOpen,
+		Origins:             modelutils.NilIfEmpty(&origins),
+		RelatedObservations: &relatedObservations,
+		Props: &[]oscalTypes.Property{
+			{Name: extensions.AssessmentRuleIdProp, Value: ruleID, Ns: extensions.TrestleNameSpace},
+		},
+	}
+
+	if r.facets == nil || len(origins) == 0 {
+		return risk
+	}
+	facets := r.facets(ruleID)
+	if len(facets) == 0 {
+		return risk
+	}
+	risk.Characterizations = &[]oscalTypes.Characterization{
+		{
+			Origin: oscalTypes.Origin{Actors: origins[0].Actors},
+			Facets: facets,
+		},
+	}
+	return risk
+}
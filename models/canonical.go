@@ -0,0 +1,206 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/oscalxml"
+)
+
+// Canonical serialization writes OSCAL properties in the order of the OSCAL Metaschema
+// instead of the alphabetical field order of the go-oscal types, so documents written
+// by the SDK are deterministic and diff cleanly.
+
+// encodeCanonicalJSON writes the OSCAL models as JSON with two space indentation.
+func encodeCanonicalJSON(writer io.Writer, oscalModels oscalTypes.OscalModels) error {
+	var buf bytes.Buffer
+	if err := writeJSONNode(&buf, canonicalNode(reflect.ValueOf(oscalModels)), 0); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+	_, err := writer.Write(buf.Bytes())
+	return err
+}
+
+// encodeCanonicalYAML writes the OSCAL models as YAML with two space indentation.
+func encodeCanonicalYAML(writer io.Writer, oscalModels oscalTypes.OscalModels) error {
+	enc := yaml.NewEncoder(writer)
+	enc.SetIndent(2)
+	if err := enc.Encode(canonicalNode(reflect.ValueOf(oscalModels))); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// canonicalNode returns the YAML node tree for a value with the same content as
+// its encoding/json representation. Struct properties are in canonical order. It
+// returns nil for values that are omitted.
+func canonicalNode(v reflect.Value) *yaml.Node {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return canonicalNode(v.Elem())
+	case reflect.String:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v.String()}
+	case reflect.Int, reflect.Int64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(v.Int(), 10)}
+	case reflect.Float64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: strconv.FormatFloat(v.Float(), 'g', -1, 64)}
+	case reflect.Bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v.Bool())}
+	case reflect.Slice:
+		sequence := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for i := 0; i < v.Len(); i++ {
+			if item := canonicalNode(v.Index(i)); item != nil {
+				sequence.Content = append(sequence.Content, item)
+			}
+		}
+		return sequence
+	case reflect.Map:
+		mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		keys := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if value := canonicalNode(v.MapIndex(reflect.ValueOf(key))); value != nil {
+				mapping.Content = append(mapping.Content, stringNode(key), value)
+			}
+		}
+		return mapping
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return stringNode(v.Interface().(time.Time).Format(time.RFC3339Nano))
+		}
+		return structNode(v)
+	default:
+		return nil
+	}
+}
+
+func structNode(v reflect.Value) *yaml.Node {
+	type jsonField struct {
+		index     int
+		omitEmpty bool
+	}
+	fields := make(map[string]jsonField)
+	for i := 0; i < v.NumField(); i++ {
+		name, options, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = jsonField{index: i, omitEmpty: options == "omitempty"}
+		}
+	}
+
+	mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, name := range oscalxml.PropertyOrder(v.Type()) {
+		f := v.Field(fields[name].index)
+		if fields[name].omitEmpty && isEmptyValue(f) {
+			continue
+		}
+		value := canonicalNode(f)
+		if value == nil {
+			continue
+		}
+		mapping.Content = append(mapping.Content, stringNode(name), value)
+	}
+	return mapping
+}
+
+func stringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// isEmptyValue reports whether the value is omitted by the encoding/json omitempty option.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool, reflect.Int, reflect.Int64, reflect.Float64:
+		return v.IsZero()
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// writeJSONNode writes the YAML node tree as indented JSON.
+func writeJSONNode(buf *bytes.Buffer, n *yaml.Node, depth int) error {
+	indent := strings.Repeat("  ", depth)
+	switch n.Kind {
+	case yaml.MappingNode:
+		if len(n.Content) == 0 {
+			buf.WriteString("{}")
+			return nil
+		}
+		buf.WriteString("{\n")
+		for i := 0; i < len(n.Content); i += 2 {
+			buf.WriteString(indent + "  ")
+			if err := writeJSONString(buf, n.Content[i].Value); err != nil {
+				return err
+			}
+			buf.WriteString(": ")
+			if err := writeJSONNode(buf, n.Content[i+1], depth+1); err != nil {
+				return err
+			}
+			if i+2 < len(n.Content) {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "}")
+	case yaml.SequenceNode:
+		if len(n.Content) == 0 {
+			buf.WriteString("[]")
+			return nil
+		}
+		buf.WriteString("[\n")
+		for i, item := range n.Content {
+			buf.WriteString(indent + "  ")
+			if err := writeJSONNode(buf, item, depth+1); err != nil {
+				return err
+			}
+			if i+1 < len(n.Content) {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "]")
+	default:
+		if n.Tag == "!!str" {
+			return writeJSONString(buf, n.Value)
+		}
+		buf.WriteString(n.Value)
+	}
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, value string) error {
+	var encoded bytes.Buffer
+	enc := json.NewEncoder(&encoded)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+	buf.Write(bytes.TrimSuffix(encoded.Bytes(), []byte("\n")))
+	return nil
+}
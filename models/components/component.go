@@ -7,6 +7,8 @@ package components
 
 import (
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
 )
 
 // ComponentConverter defines methods to convert and/or retrieve OSCAL Component underlying types.
@@ -37,7 +39,7 @@ type Component interface {
 type ComponentType string
 
 const (
-	Validation       ComponentType = "validation"
+	Validation       ComponentType = extensions.ValidationComponentType
 	Software         ComponentType = "software"
 	Service          ComponentType = "service"
 	Interconnection  ComponentType = "interconnection"
@@ -0,0 +1,159 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/oscalxml"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+// Format is a serialization format for OSCAL documents.
+type Format string
+
+const (
+	// FormatUnknown is used to detect the format from the document content.
+	FormatUnknown Format = ""
+	// FormatJSON is the OSCAL JSON format.
+	FormatJSON Format = "json"
+	// FormatYAML is the OSCAL YAML format.
+	FormatYAML Format = "yaml"
+	// FormatXML is the OSCAL XML format.
+	FormatXML Format = "xml"
+)
+
+// ErrUnsupportedFormat is returned when a format is not supported.
+var ErrUnsupportedFormat = errors.New("unsupported format")
+
+// DetectFormat returns the format of an OSCAL document from its content.
+// Content that is neither JSON nor XML is treated as YAML.
+func DetectFormat(content []byte) Format {
+	trimmed := bytes.TrimLeft(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")), " \t\r\n")
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return FormatJSON
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return FormatXML
+	default:
+		return FormatYAML
+	}
+}
+
+// FormatFromPath returns the format of an OSCAL document from the file extension
+// or FormatUnknown if the extension is not recognized.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".xml":
+		return FormatXML
+	default:
+		return FormatUnknown
+	}
+}
+
+// Decode reads OSCAL models in the given format and validates them with the validator.
+// With FormatUnknown, the format is detected from the content.
+func Decode(reader io.Reader, format Format, validator validation.Validator) (oscalTypes.OscalModels, error) {
+	var oscalModels oscalTypes.OscalModels
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return oscalModels, err
+	}
+	if format == FormatUnknown {
+		format = DetectFormat(content)
+	}
+
+	switch format {
+	case FormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&oscalModels)
+	case FormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(content))
+		dec.KnownFields(true)
+		err = dec.Decode(&oscalModels)
+	case FormatXML:
+		err = oscalxml.Unmarshal(content, &oscalModels)
+		if errors.Is(err, oscalxml.ErrUnknownRoot) {
+			err = fmt.Errorf("%w: %w", ErrNoModel, err)
+		}
+	default:
+		return oscalModels, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+	if err != nil {
+		return oscalModels, err
+	}
+
+	if err = validator.Validate(oscalModels); err != nil {
+		return oscalModels, err
+	}
+	return oscalModels, nil
+}
+
+// DecodeFile reads OSCAL models from a file. The format is detected from the file
+// extension, or from the content if the extension is not recognized.
+func DecodeFile(path string, validator validation.Validator) (oscalTypes.OscalModels, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+	defer file.Close()
+	return Decode(file, FormatFromPath(path), validator)
+}
+
+// Encode validates the OSCAL models with the validator and writes them in the given format.
+// Properties are written in the canonical OSCAL order with two space indentation.
+func Encode(writer io.Writer, oscalModels oscalTypes.OscalModels, format Format, validator validation.Validator) error {
+	if err := validator.Validate(oscalModels); err != nil {
+		return err
+	}
+
+	switch format {
+	case FormatJSON:
+		return encodeCanonicalJSON(writer, oscalModels)
+	case FormatYAML:
+		return encodeCanonicalYAML(writer, oscalModels)
+	case FormatXML:
+		data, err := oscalxml.Marshal(&oscalModels)
+		if errors.Is(err, oscalxml.ErrNoModel) {
+			return ErrNoModel
+		}
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(data)
+		return err
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+// EncodeFile writes the OSCAL models to a file in the format of the file extension.
+func EncodeFile(path string, oscalModels oscalTypes.OscalModels, validator validation.Validator) error {
+	format := FormatFromPath(path)
+	if format == FormatUnknown {
+		return fmt.Errorf("%w: cannot determine format of %q", ErrUnsupportedFormat, path)
+	}
+	var buf bytes.Buffer
+	if err := Encode(&buf, oscalModels, format, validator); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Clean(path), buf.Bytes(), 0600)
+}
@@ -0,0 +1,146 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		content string
+		want    Format
+	}{
+		{content: `{"catalog": {}}`, want: FormatJSON},
+		{content: "\xef\xbb\xbf\n  {}", want: FormatJSON},
+		{content: `<?xml version="1.0"?><catalog/>`, want: FormatXML},
+		{content: "catalog:\n  uuid: x\n", want: FormatYAML},
+		{content: "---\ncatalog: {}\n", want: FormatYAML},
+	}
+	for _, c := range tests {
+		require.Equal(t, c.want, DetectFormat([]byte(c.content)), c.content)
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	tests := map[string]Format{
+		"catalog.json":     FormatJSON,
+		"dir/profile.yaml": FormatYAML,
+		"ssp.YML":          FormatYAML,
+		"ap.xml":           FormatXML,
+		"component":        FormatUnknown,
+	}
+	for path, want := range tests {
+		require.Equal(t, want, FormatFromPath(path), path)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	data, err := os.ReadFile("../testdata/test-ssp.json")
+	require.NoError(t, err)
+	var want oscalTypes.OscalModels
+	require.NoError(t, json.Unmarshal(data, &want))
+
+	for _, format := range []Format{FormatJSON, FormatYAML, FormatXML} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Encode(&buf, want, format, validation.NoopValidator{}))
+			require.Equal(t, format, DetectFormat(buf.Bytes()))
+
+			got, err := Decode(&buf, FormatUnknown, validation.NoopValidator{})
+			require.NoError(t, err)
+			requireModelsEqual(t, want, got)
+		})
+	}
+
+	_, err = Decode(bytes.NewReader(data), Format("toml"), validation.NoopValidator{})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	err = Encode(&bytes.Buffer{}, want, Format("toml"), validation.NoopValidator{})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestDecode_UnknownFields(t *testing.T) {
+	tests := []struct {
+		format  Format
+		content string
+	}{
+		{format: FormatJSON, content: `{"catalog": {"unknown": "value"}}`},
+		{format: FormatYAML, content: "catalog:\n  unknown: value\n"},
+		{format: FormatXML, content: `<catalog><unknown/></catalog>`},
+	}
+	for _, c := range tests {
+		t.Run(string(c.format), func(t *testing.T) {
+			_, err := Decode(bytes.NewReader([]byte(c.content)), FormatUnknown, validation.NoopValidator{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEncodeDecodeFile(t *testing.T) {
+	catalog, err := os.ReadFile("../testdata/test-catalog.json")
+	require.NoError(t, err)
+	want, err := Decode(bytes.NewReader(catalog), FormatJSON, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	tmpDir := t.TempDir()
+	for _, name := range []string{"catalog.json", "catalog.yaml", "catalog.xml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(tmpDir, name)
+			require.NoError(t, EncodeFile(path, want, validation.NoopValidator{}))
+
+			got, err := DecodeFile(path, validation.NoopValidator{})
+			require.NoError(t, err)
+			requireModelsEqual(t, want, got)
+
+			gotCatalog, err := NewCatalog(bytes.NewReader(mustReadFile(t, path)), validation.NoopValidator{})
+			require.NoError(t, err)
+			require.Equal(t, want.Catalog.UUID, gotCatalog.UUID)
+		})
+	}
+
+	err = EncodeFile(filepath.Join(tmpDir, "catalog"), want, validation.NoopValidator{})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestEncodeDecode_Validator(t *testing.T) {
+	wantErr := errors.New("invalid")
+	failing := validation.ValidatorFunc(func(oscalTypes.OscalModels) error { return wantErr })
+	models := oscalTypes.OscalModels{Catalog: &oscalTypes.Catalog{UUID: "c1"}}
+
+	var buf bytes.Buffer
+	require.ErrorIs(t, Encode(&buf, models, FormatYAML, failing), wantErr)
+	require.Zero(t, buf.Len())
+
+	require.NoError(t, Encode(&buf, models, FormatYAML, validation.NoopValidator{}))
+	_, err := Decode(&buf, FormatUnknown, failing)
+	require.ErrorIs(t, err, wantErr)
+}
+
+func requireModelsEqual(t *testing.T, want, got oscalTypes.OscalModels) {
+	t.Helper()
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got)
+	require.NoError(t, err)
+	require.JSONEq(t, string(wantJSON), string(gotJSON))
+}
+
+func mustReadFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package hrefs defines logic for resolving the hrefs in OSCAL documents, such as
+// imports and back-matter resource links, to the OSCAL models they reference.
+package hrefs
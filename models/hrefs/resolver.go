@@ -0,0 +1,333 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package hrefs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/profiles"
+	"github.com/oscal-compass/oscal-sdk-go/models/workspace"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+var (
+	_ Resolver         = (*FileResolver)(nil)
+	_ Decoder          = (*FileResolver)(nil)
+	_ profiles.Loader  = (*sourceLoader)(nil)
+	_ profiles.Decoder = (*decodingSourceLoader)(nil)
+)
+
+var (
+	// ErrUnsupportedHref defines an error returned when an href cannot be resolved.
+	ErrUnsupportedHref = errors.New("unsupported href")
+	// ErrResourceNotFound defines an error returned when a back-matter resource
+	// referenced by a fragment href cannot be found.
+	ErrResourceNotFound = errors.New("back-matter resource not found")
+	// ErrHashMismatch defines an error returned when referenced content does not
+	// match a hash of the resource link.
+	ErrHashMismatch = errors.New("resource hash mismatch")
+	// ErrUnsupportedHashAlgorithm defines an error returned when a resource link hash
+	// uses an algorithm that cannot be verified.
+	ErrUnsupportedHashAlgorithm = errors.New("unsupported hash algorithm")
+)
+
+// Source is an OSCAL document that holds an href.
+type Source struct {
+	// Path is the file path of the document. Relative hrefs are resolved against
+	// its directory, or the working directory if empty.
+	Path string
+	// BackMatter is the back-matter of the document used to resolve fragment
+	// hrefs (e.g. "#uuid").
+	BackMatter *oscalTypes.BackMatter
+}
+
+// NewSource returns the Source for a document loaded from the given path.
+func NewSource(path string, document models.Document) Source {
+	return Source{
+		Path:       path,
+		BackMatter: backMatterOf(document),
+	}
+}
+
+// Resolver defines methods for resolving hrefs in OSCAL documents.
+type Resolver interface {
+	// Resolve returns the OSCAL document referenced by an href in the source document.
+	Resolve(ctx context.Context, source Source, href string) (models.Document, error)
+}
+
+// Decoder is implemented by Resolvers that decode content embedded in OSCAL documents
+// with the same validation as resolved documents.
+type Decoder interface {
+	// Decode returns the OSCAL document for embedded content.
+	Decode(ctx context.Context, content []byte) (models.Document, error)
+}
+
+type resolverOpts struct {
+	validator validation.Validator
+	workspace *workspace.Workspace
+}
+
+func (r *resolverOpts) defaults() {
+	r.validator = validation.NoopValidator{}
+}
+
+// Option defines an option to tune the behavior of a FileResolver.
+type Option func(opts *resolverOpts)
+
+// WithValidator is an Option that validates referenced models with the given validator.
+func WithValidator(validator validation.Validator) Option {
+	return func(opts *resolverOpts) {
+		opts.validator = validator
+	}
+}
+
+// WithWorkspace is an Option that resolves `trestle://` URIs against the given trestle
+// workspace. Without a workspace, `trestle://` URIs are not supported.
+func WithWorkspace(ws *workspace.Workspace) Option {
+	return func(opts *resolverOpts) {
+		opts.workspace = ws
+	}
+}
+
+// FileResolver implements the Resolver interface for OSCAL documents stored on the
+// local filesystem.
+type FileResolver struct {
+	validator validation.Validator
+	workspace *workspace.Workspace
+}
+
+// NewFileResolver returns a new FileResolver.
+func NewFileResolver(opts ...Option) *FileResolver {
+	options := resolverOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &FileResolver{
+		validator: options.validator,
+		workspace: options.workspace,
+	}
+}
+
+// Resolve returns the OSCAL document referenced by an href. Supported hrefs are:
+//   - Back-matter resource fragments (e.g. "#uuid"), resolved with the embedded base64
+//     content or the first resource link that can be resolved
+//   - `trestle://` URIs, when a workspace is configured
+//   - `file://` URLs
+//   - Absolute paths and paths relative to the source document
+//
+// Content referenced by a resource link is verified against the link hashes.
+func (f *FileResolver) Resolve(ctx context.Context, source Source, href string) (models.Document, error) {
+	if !strings.HasPrefix(href, "#") {
+		return f.resolveLink(source, href, nil)
+	}
+
+	resourceUUID := strings.TrimPrefix(href, "#")
+	resource, err := findResource(source.BackMatter, resourceUUID)
+	if err != nil {
+		return models.Document{}, err
+	}
+
+	if resource.Base64 != nil {
+		content, err := base64.StdEncoding.DecodeString(resource.Base64.Value)
+		if err != nil {
+			return models.Document{}, fmt.Errorf("failed to decode resource %q: %w", resource.UUID, err)
+		}
+		document, err := f.Decode(ctx, content)
+		if err != nil {
+			return models.Document{}, fmt.Errorf("failed to load resource %q: %w", resource.UUID, err)
+		}
+		return document, nil
+	}
+
+	if resource.Rlinks == nil || len(*resource.Rlinks) == 0 {
+		return models.Document{}, fmt.Errorf("resource %q has no content or links: %w", resource.UUID, ErrUnsupportedHref)
+	}
+	var errs []error
+	for _, rlink := range *resource.Rlinks {
+		var hashes []oscalTypes.Hash
+		if rlink.Hashes != nil {
+			hashes = *rlink.Hashes
+		}
+		document, err := f.resolveLink(source, rlink.Href, hashes)
+		switch {
+		case err == nil:
+			return document, nil
+		case errors.Is(err, ErrHashMismatch):
+			// Content that does not match its hash is never used.
+			return models.Document{}, fmt.Errorf("resource %q: %w", resource.UUID, err)
+		}
+		errs = append(errs, err)
+	}
+	return models.Document{}, fmt.Errorf("failed to resolve resource %q: %w", resource.UUID, errors.Join(errs...))
+}
+
+// Decode returns the OSCAL document for embedded content, such as a base64 back-matter
+// resource, validated with the resolver validator.
+func (f *FileResolver) Decode(_ context.Context, content []byte) (models.Document, error) {
+	return models.Load(bytes.NewReader(content), f.validator)
+}
+
+// Loader returns a profiles.Loader that resolves hrefs in the source document, so
+// profile imports can be resolved with the Resolver. The Loader also implements
+// profiles.Decoder if the Resolver implements Decoder.
+func Loader(resolver Resolver, source Source) profiles.Loader {
+	loader := &sourceLoader{resolver: resolver, source: source}
+	if decoder, ok := resolver.(Decoder); ok {
+		return &decodingSourceLoader{sourceLoader: loader, decoder: decoder}
+	}
+	return loader
+}
+
+// sourceLoader adapts a Resolver to a profiles.Loader for a source document.
+type sourceLoader struct {
+	resolver Resolver
+	source   Source
+}
+
+func (s *sourceLoader) Load(ctx context.Context, href string) (oscalTypes.OscalModels, error) {
+	document, err := s.resolver.Resolve(ctx, s.source, href)
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+	return document.Models, nil
+}
+
+// decodingSourceLoader is a sourceLoader that decodes embedded content with the Decoder
+// of the resolver.
+type decodingSourceLoader struct {
+	*sourceLoader
+	decoder Decoder
+}
+
+func (d *decodingSourceLoader) Decode(ctx context.Context, content []byte) (oscalTypes.OscalModels, error) {
+	document, err := d.decoder.Decode(ctx, content)
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+	return document.Models, nil
+}
+
+// resolveLink loads the document at a link href and verifies it against the given hashes.
+func (f *FileResolver) resolveLink(source Source, href string, hashes []oscalTypes.Hash) (models.Document, error) {
+	path, err := f.path(source, href)
+	if err != nil {
+		return models.Document{}, err
+	}
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return models.Document{}, fmt.Errorf("failed to load %q: %w", href, err)
+	}
+	if err := verifyHashes(content, hashes); err != nil {
+		return models.Document{}, fmt.Errorf("failed to verify %q: %w", href, err)
+	}
+	document, err := models.Load(bytes.NewReader(content), f.validator, models.WithFormat(models.FormatFromPath(path)))
+	if err != nil {
+		return models.Document{}, fmt.Errorf("failed to load %q: %w", href, err)
+	}
+	return document, nil
+}
+
+// path returns the filesystem path for a link href in the source document.
+func (f *FileResolver) path(source Source, href string) (string, error) {
+	switch {
+	case strings.HasPrefix(href, workspace.TrestleScheme):
+		if f.workspace == nil {
+			return "", fmt.Errorf("href %q: no workspace configured: %w", href, ErrUnsupportedHref)
+		}
+		return f.workspace.ResolveHref(href)
+	case strings.HasPrefix(href, "file://"):
+		parsed, err := url.Parse(href)
+		if err != nil {
+			return "", fmt.Errorf("invalid href %q: %w", href, err)
+		}
+		return filepath.FromSlash(parsed.Path), nil
+	case strings.Contains(href, "://"), strings.HasPrefix(href, "#"):
+		return "", fmt.Errorf("href %q: %w", href, ErrUnsupportedHref)
+	case filepath.IsAbs(href):
+		return href, nil
+	default:
+		return filepath.Join(filepath.Dir(source.Path), filepath.FromSlash(href)), nil
+	}
+}
+
+// findResource returns the back-matter resource with the given UUID.
+func findResource(backMatter *oscalTypes.BackMatter, resourceUUID string) (oscalTypes.Resource, error) {
+	if backMatter != nil && backMatter.Resources != nil {
+		for _, resource := range *backMatter.Resources {
+			if resource.UUID == resourceUUID {
+				return resource, nil
+			}
+		}
+	}
+	return oscalTypes.Resource{}, fmt.Errorf("resource %q: %w", resourceUUID, ErrResourceNotFound)
+}
+
+// hashFuncs are the OSCAL hash algorithms that can be verified.
+var hashFuncs = map[string]func() hash.Hash{
+	"SHA-224":  sha256.New224,
+	"SHA-256":  sha256.New,
+	"SHA-384":  sha512.New384,
+	"SHA-512":  sha512.New,
+	"SHA3-224": func() hash.Hash { return sha3.New224() },
+	"SHA3-256": func() hash.Hash { return sha3.New256() },
+	"SHA3-384": func() hash.Hash { return sha3.New384() },
+	"SHA3-512": func() hash.Hash { return sha3.New512() },
+}
+
+// verifyHashes checks the content against all given hashes.
+func verifyHashes(content []byte, hashes []oscalTypes.Hash) error {
+	for _, expected := range hashes {
+		newHash, found := hashFuncs[strings.ToUpper(expected.Algorithm)]
+		if !found {
+			return fmt.Errorf("%w: %q", ErrUnsupportedHashAlgorithm, expected.Algorithm)
+		}
+		h := newHash()
+		h.Write(content)
+		if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected.Value) {
+			return fmt.Errorf("%w: %s expected %s, found %s", ErrHashMismatch, expected.Algorithm, expected.Value, actual)
+		}
+	}
+	return nil
+}
+
+// backMatterOf returns the back-matter of the model in the document.
+func backMatterOf(document models.Document) *oscalTypes.BackMatter {
+	switch model := document.Model().(type) {
+	case *oscalTypes.Catalog:
+		return model.BackMatter
+	case *oscalTypes.Profile:
+		return model.BackMatter
+	case *oscalTypes.ComponentDefinition:
+		return model.BackMatter
+	case *oscalTypes.SystemSecurityPlan:
+		return model.BackMatter
+	case *oscalTypes.AssessmentPlan:
+		return model.BackMatter
+	case *oscalTypes.AssessmentResults:
+		return model.BackMatter
+	case *oscalTypes.PlanOfActionAndMilestones:
+		return model.BackMatter
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package hrefs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/profiles"
+	"github.com/oscal-compass/oscal-sdk-go/models/workspace"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestFileResolver_Resolve(t *testing.T) {
+	catalog, err := os.ReadFile("../../testdata/test-catalog.json")
+	require.NoError(t, err)
+	sum := sha256.Sum256(catalog)
+	catalogHash := hex.EncodeToString(sum[:])
+
+	root := t.TempDir()
+	catalogPath := filepath.Join(root, "catalogs", "nist", "catalog.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(catalogPath), 0700))
+	require.NoError(t, os.WriteFile(catalogPath, catalog, 0600))
+	ws, err := workspace.Open(root)
+	require.NoError(t, err)
+
+	rlinkResource := func(resourceUUID, href string, hashes ...oscalTypes.Hash) oscalTypes.Resource {
+		rlink := oscalTypes.ResourceLink{Href: href}
+		if len(hashes) > 0 {
+			rlink.Hashes = &hashes
+		}
+		return oscalTypes.Resource{UUID: resourceUUID, Rlinks: &[]oscalTypes.ResourceLink{rlink}}
+	}
+	source := Source{
+		Path: filepath.Join(root, "profiles", "example", "profile.json"),
+		BackMatter: &oscalTypes.BackMatter{
+			Resources: &[]oscalTypes.Resource{
+				{UUID: "base64", Base64: &oscalTypes.Base64{Value: base64.StdEncoding.EncodeToString(catalog)}},
+				rlinkResource("relative", "../../catalogs/nist/catalog.json"),
+				rlinkResource("hash", "trestle://catalogs/nist/catalog.json", oscalTypes.Hash{Algorithm: "SHA-256", Value: catalogHash}),
+				rlinkResource("bad-hash", "trestle://catalogs/nist/catalog.json", oscalTypes.Hash{Algorithm: "SHA-256", Value: "00"}),
+				rlinkResource("unknown-hash", "trestle://catalogs/nist/catalog.json", oscalTypes.Hash{Algorithm: "MD5", Value: "00"}),
+				{
+					UUID: "fallback",
+					Rlinks: &[]oscalTypes.ResourceLink{
+						{Href: "https://example.com/catalog.json"},
+						{Href: "file://" + filepath.ToSlash(catalogPath)},
+					},
+				},
+				{UUID: "empty"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		href     string
+		expError error
+	}{
+		{name: "Success/RelativePath", href: "../../catalogs/nist/catalog.json"},
+		{name: "Success/AbsolutePath", href: catalogPath},
+		{name: "Success/FileURL", href: "file://" + filepath.ToSlash(catalogPath)},
+		{name: "Success/Trestle", href: "trestle://catalogs/nist/catalog.json"},
+		{name: "Success/Base64Resource", href: "#base64"},
+		{name: "Success/RlinkResource", href: "#relative"},
+		{name: "Success/VerifiedHash", href: "#hash"},
+		{name: "Success/RlinkFallback", href: "#fallback"},
+		{name: "Failure/HashMismatch", href: "#bad-hash", expError: ErrHashMismatch},
+		{name: "Failure/UnsupportedHashAlgorithm", href: "#unknown-hash", expError: ErrUnsupportedHashAlgorithm},
+		{name: "Failure/ResourceNotFound", href: "#missing", expError: ErrResourceNotFound},
+		{name: "Failure/EmptyResource", href: "#empty", expError: ErrUnsupportedHref},
+		{name: "Failure/UnsupportedScheme", href: "https://example.com/catalog.json", expError: ErrUnsupportedHref},
+		{name: "Failure/MissingFile", href: "missing.json", expError: os.ErrNotExist},
+	}
+	resolver := NewFileResolver(WithWorkspace(ws))
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			document, err := resolver.Resolve(context.TODO(), source, c.href)
+			if c.expError != nil {
+				require.ErrorIs(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.ModelTypeCatalog, document.Type)
+			require.Equal(t, "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d", document.Models.Catalog.UUID)
+		})
+	}
+
+	_, err = NewFileResolver().Resolve(context.TODO(), source, "trestle://catalogs/nist/catalog.json")
+	require.ErrorIs(t, err, ErrUnsupportedHref)
+}
+
+func TestLoader(t *testing.T) {
+	profilePath := "../../testdata/test-profile.json"
+	document, err := models.LoadFile(profilePath, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	source := NewSource(profilePath, document)
+	require.NotNil(t, source.BackMatter)
+
+	resolved, err := profiles.NewResolver(Loader(NewFileResolver(), source)).Resolve(context.TODO(), *document.Models.Profile)
+	require.NoError(t, err)
+	require.NotNil(t, resolved.Groups)
+}
+
+// resolverFunc is a test Resolver that does not decode embedded content.
+type resolverFunc func(ctx context.Context, source Source, href string) (models.Document, error)
+
+func (r resolverFunc) Resolve(ctx context.Context, source Source, href string) (models.Document, error) {
+	return r(ctx, source, href)
+}
+
+func TestLoader_Decoder(t *testing.T) {
+	_, ok := Loader(NewFileResolver(), Source{}).(profiles.Decoder)
+	require.True(t, ok)
+	_, ok = Loader(resolverFunc(NewFileResolver().Resolve), Source{}).(profiles.Decoder)
+	require.False(t, ok)
+}
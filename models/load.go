@@ -0,0 +1,228 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+// ModelType identifies an OSCAL model by the root key of the document.
+type ModelType string
+
+const (
+	// ModelTypeCatalog is the OSCAL Catalog model.
+	ModelTypeCatalog ModelType = "catalog"
+	// ModelTypeProfile is the OSCAL Profile model.
+	ModelTypeProfile ModelType = "profile"
+	// ModelTypeComponentDefinition is the OSCAL Component Definition model.
+	ModelTypeComponentDefinition ModelType = "component-definition"
+	// ModelTypeSystemSecurityPlan is the OSCAL System Security Plan model.
+	ModelTypeSystemSecurityPlan ModelType = "system-security-plan"
+	// ModelTypeAssessmentPlan is the OSCAL Assessment Plan model.
+	ModelTypeAssessmentPlan ModelType = "assessment-plan"
+	// ModelTypeAssessmentResults is the OSCAL Assessment Results model.
+	ModelTypeAssessmentResults ModelType = "assessment-results"
+	// ModelTypePOAM is the OSCAL Plan of Action and Milestones model.
+	ModelTypePOAM ModelType = "plan-of-action-and-milestones"
+)
+
+var (
+	// ErrNoModel is returned when a document does not hold an OSCAL model.
+	ErrNoModel = errors.New("no OSCAL model found")
+	// ErrMultipleModels is returned when a document holds more than one OSCAL model.
+	ErrMultipleModels = errors.New("multiple OSCAL models found")
+	// ErrUnexpectedModel is returned when a document holds a different OSCAL model
+	// than expected.
+	ErrUnexpectedModel = errors.New("unexpected OSCAL model")
+)
+
+// Model is the set of OSCAL model types that can be loaded.
+type Model interface {
+	oscalTypes.Catalog |
+		oscalTypes.Profile |
+		oscalTypes.ComponentDefinition |
+		oscalTypes.SystemSecurityPlan |
+		oscalTypes.AssessmentPlan |
+		oscalTypes.AssessmentResults |
+		oscalTypes.PlanOfActionAndMilestones
+}
+
+// Document is a loaded OSCAL document holding exactly one model.
+type Document struct {
+	// Type is the type of the model in the document.
+	Type ModelType
+	// Models holds the model. Only the field for Type is set.
+	Models oscalTypes.OscalModels
+}
+
+// Model returns the model in the document as a pointer to its go-oscal type,
+// e.g. *oscalTypes.Catalog for a catalog.
+func (d Document) Model() interface{} {
+	switch d.Type {
+	case ModelTypeCatalog:
+		return d.Models.Catalog
+	case ModelTypeProfile:
+		return d.Models.Profile
+	case ModelTypeComponentDefinition:
+		return d.Models.ComponentDefinition
+	case ModelTypeSystemSecurityPlan:
+		return d.Models.SystemSecurityPlan
+	case ModelTypeAssessmentPlan:
+		return d.Models.AssessmentPlan
+	case ModelTypeAssessmentResults:
+		return d.Models.AssessmentResults
+	case ModelTypePOAM:
+		return d.Models.PlanOfActionAndMilestones
+	default:
+		return nil
+	}
+}
+
+// DetectModelType returns the type of the single model set in the OSCAL models.
+func DetectModelType(oscalModels oscalTypes.OscalModels) (ModelType, error) {
+	var found []ModelType
+	candidates := []struct {
+		modelType ModelType
+		set       bool
+	}{
+		{ModelTypeCatalog, oscalModels.Catalog != nil},
+		{ModelTypeProfile, oscalModels.Profile != nil},
+		{ModelTypeComponentDefinition, oscalModels.ComponentDefinition != nil},
+		{ModelTypeSystemSecurityPlan, oscalModels.SystemSecurityPlan != nil},
+		{ModelTypeAssessmentPlan, oscalModels.AssessmentPlan != nil},
+		{ModelTypeAssessmentResults, oscalModels.AssessmentResults != nil},
+		{ModelTypePOAM, oscalModels.PlanOfActionAndMilestones != nil},
+	}
+	for _, candidate := range candidates {
+		if candidate.set {
+			found = append(found, candidate.modelType)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return "", ErrNoModel
+	case 1:
+		return found[0], nil
+	default:
+		names := make([]string, 0, len(found))
+		for _, modelType := range found {
+			names = append(names, string(modelType))
+		}
+		return "", fmt.Errorf("%w: %s", ErrMultipleModels, strings.Join(names, ", "))
+	}
+}
+
+// ModelTypeOf returns the ModelType of the go-oscal model type T.
+func ModelTypeOf[T Model]() ModelType {
+	switch any(new(T)).(type) {
+	case *oscalTypes.Catalog:
+		return ModelTypeCatalog
+	case *oscalTypes.Profile:
+		return ModelTypeProfile
+	case *oscalTypes.ComponentDefinition:
+		return ModelTypeComponentDefinition
+	case *oscalTypes.SystemSecurityPlan:
+		return ModelTypeSystemSecurityPlan
+	case *oscalTypes.AssessmentPlan:
+		return ModelTypeAssessmentPlan
+	case *oscalTypes.AssessmentResults:
+		return ModelTypeAssessmentResults
+	default:
+		return ModelTypePOAM
+	}
+}
+
+type loadOptions struct {
+	format    Format
+	modelType ModelType
+}
+
+// LoadOption defines an option for loading OSCAL documents.
+type LoadOption func(opts *loadOptions)
+
+// WithFormat defines a LoadOption to decode the document in the given format
+// instead of detecting it.
+func WithFormat(format Format) LoadOption {
+	return func(opts *loadOptions) {
+		opts.format = format
+	}
+}
+
+// WithModelType defines a LoadOption to require the document to hold the given model type.
+func WithModelType(modelType ModelType) LoadOption {
+	return func(opts *loadOptions) {
+		opts.modelType = modelType
+	}
+}
+
+// Load reads an OSCAL document, detects the model type it holds and validates it
+// with the validator. The model type is checked before validation, so a document
+// with an unexpected model returns ErrUnexpectedModel without running the validator.
+func Load(reader io.Reader, validator validation.Validator, opts ...LoadOption) (Document, error) {
+	var options loadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	oscalModels, err := Decode(reader, options.format, validation.NoopValidator{})
+	if err != nil {
+		return Document{}, err
+	}
+
+	modelType, err := DetectModelType(oscalModels)
+	if err != nil {
+		return Document{}, err
+	}
+	if options.modelType != "" && modelType != options.modelType {
+		return Document{}, fmt.Errorf("%w: expected %s, found %s", ErrUnexpectedModel, options.modelType, modelType)
+	}
+
+	if err = validator.Validate(oscalModels); err != nil {
+		return Document{}, err
+	}
+	return Document{Type: modelType, Models: oscalModels}, nil
+}
+
+// LoadFile reads an OSCAL document from a file with Load. Unless set with WithFormat,
+// the format is detected from the file extension or the content.
+func LoadFile(path string, validator validation.Validator, opts ...LoadOption) (Document, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return Document{}, err
+	}
+	defer file.Close()
+	opts = append([]LoadOption{WithFormat(FormatFromPath(path))}, opts...)
+	return Load(file, validator, opts...)
+}
+
+// LoadAs reads an OSCAL document with Load and returns its model as the go-oscal type T.
+func LoadAs[T Model](reader io.Reader, validator validation.Validator, opts ...LoadOption) (*T, error) {
+	opts = append(opts, WithModelType(ModelTypeOf[T]()))
+	document, err := Load(reader, validator, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return As[T](document)
+}
+
+// As returns the model in the document as the go-oscal type T.
+func As[T Model](document Document) (*T, error) {
+	model, ok := document.Model().(*T)
+	if !ok || model == nil {
+		return nil, fmt.Errorf("%w: expected %s, found %s", ErrUnexpectedModel, ModelTypeOf[T](), document.Type)
+	}
+	return model, nil
+}
@@ -0,0 +1,163 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		testDataPath string
+		wantType     ModelType
+	}{
+		{testDataPath: "../testdata/test-catalog.json", wantType: ModelTypeCatalog},
+		{testDataPath: "../testdata/test-profile.json", wantType: ModelTypeProfile},
+		{testDataPath: "../testdata/component-definition-test.json", wantType: ModelTypeComponentDefinition},
+		{testDataPath: "../testdata/test-ssp.json", wantType: ModelTypeSystemSecurityPlan},
+		{testDataPath: "../testdata/test-ap.json", wantType: ModelTypeAssessmentPlan},
+	}
+	for _, c := range tests {
+		t.Run(c.testDataPath, func(t *testing.T) {
+			document, err := LoadFile(c.testDataPath, validation.NoopValidator{})
+			require.NoError(t, err)
+			require.Equal(t, c.wantType, document.Type)
+			require.NotNil(t, document.Model())
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		opts     []LoadOption
+		wantType ModelType
+		wantErr  error
+	}{
+		{
+			name:     "Valid/YAML",
+			content:  "assessment-results:\n  uuid: ar-1\n",
+			wantType: ModelTypeAssessmentResults,
+		},
+		{
+			name:     "Valid/ExpectedModelType",
+			content:  `{"plan-of-action-and-milestones": {"uuid": "poam-1"}}`,
+			opts:     []LoadOption{WithModelType(ModelTypePOAM)},
+			wantType: ModelTypePOAM,
+		},
+		{
+			name:    "Invalid/UnexpectedModelType",
+			content: `{"catalog": {"uuid": "c1"}}`,
+			opts:    []LoadOption{WithModelType(ModelTypeProfile)},
+			wantErr: ErrUnexpectedModel,
+		},
+		{
+			name:    "Invalid/NoModel",
+			content: `{}`,
+			wantErr: ErrNoModel,
+		},
+		{
+			name:    "Invalid/NoModelXML",
+			content: `<not-oscal/>`,
+			wantErr: ErrNoModel,
+		},
+		{
+			name:    "Invalid/MultipleModels",
+			content: `{"catalog": {"uuid": "c1"}, "profile": {"uuid": "p1"}}`,
+			wantErr: ErrMultipleModels,
+		},
+		{
+			name:    "Invalid/Format",
+			content: `{"catalog": {"uuid": "c1"}}`,
+			opts:    []LoadOption{WithFormat(FormatXML)},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			document, err := Load(strings.NewReader(c.content), validation.NoopValidator{}, c.opts...)
+			if c.wantType == "" {
+				require.Error(t, err)
+				if c.wantErr != nil {
+					require.ErrorIs(t, err, c.wantErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.wantType, document.Type)
+		})
+	}
+}
+
+func TestLoad_Validator(t *testing.T) {
+	wantErr := errors.New("invalid")
+	calls := 0
+	failing := validation.ValidatorFunc(func(oscalTypes.OscalModels) error {
+		calls++
+		return wantErr
+	})
+
+	_, err := Load(strings.NewReader(`{"catalog": {"uuid": "c1"}}`), failing)
+	require.ErrorIs(t, err, wantErr)
+	require.Equal(t, 1, calls)
+
+	// The model type is checked before validation.
+	_, err = Load(strings.NewReader(`{"catalog": {"uuid": "c1"}}`), failing, WithModelType(ModelTypeProfile))
+	require.ErrorIs(t, err, ErrUnexpectedModel)
+	require.Equal(t, 1, calls)
+}
+
+func TestLoadAs(t *testing.T) {
+	data, err := os.ReadFile("../testdata/test-catalog.json")
+	require.NoError(t, err)
+
+	catalog, err := LoadAs[oscalTypes.Catalog](bytes.NewReader(data), validation.NoopValidator{})
+	require.NoError(t, err)
+	require.Equal(t, "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d", catalog.UUID)
+
+	_, err = LoadAs[oscalTypes.Profile](bytes.NewReader(data), validation.NoopValidator{})
+	require.EqualError(t, err, "unexpected OSCAL model: expected profile, found catalog")
+
+	profile, err := NewProfile(bytes.NewReader(data), validation.NoopValidator{})
+	require.ErrorIs(t, err, ErrUnexpectedModel)
+	require.Nil(t, profile)
+}
+
+func TestAs(t *testing.T) {
+	document := Document{
+		Type:   ModelTypeComponentDefinition,
+		Models: oscalTypes.OscalModels{ComponentDefinition: &oscalTypes.ComponentDefinition{UUID: "cd-1"}},
+	}
+
+	definition, err := As[oscalTypes.ComponentDefinition](document)
+	require.NoError(t, err)
+	require.Equal(t, "cd-1", definition.UUID)
+
+	_, err = As[oscalTypes.SystemSecurityPlan](document)
+	require.ErrorIs(t, err, ErrUnexpectedModel)
+
+	_, err = As[oscalTypes.Catalog](Document{})
+	require.ErrorIs(t, err, ErrUnexpectedModel)
+}
+
+func TestModelTypeOf(t *testing.T) {
+	require.Equal(t, ModelTypeCatalog, ModelTypeOf[oscalTypes.Catalog]())
+	require.Equal(t, ModelTypeProfile, ModelTypeOf[oscalTypes.Profile]())
+	require.Equal(t, ModelTypeComponentDefinition, ModelTypeOf[oscalTypes.ComponentDefinition]())
+	require.Equal(t, ModelTypeSystemSecurityPlan, ModelTypeOf[oscalTypes.SystemSecurityPlan]())
+	require.Equal(t, ModelTypeAssessmentPlan, ModelTypeOf[oscalTypes.AssessmentPlan]())
+	require.Equal(t, ModelTypeAssessmentResults, ModelTypeOf[oscalTypes.AssessmentResults]())
+	require.Equal(t, ModelTypePOAM, ModelTypeOf[oscalTypes.PlanOfActionAndMilestones]())
+}
@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package poams defines logic for managing the lifecycle of the items of OSCAL Plans
+// of Action and Milestones.
+package poams
@@ -0,0 +1,484 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+)
+
+var (
+	// ErrItemNotFound defines an error returned when a POA&M item does not exist.
+	ErrItemNotFound = errors.New("poam item not found")
+	// ErrItemExists defines an error returned when opening a POA&M item with the UUID
+	// of an existing item.
+	ErrItemExists = errors.New("poam item already exists")
+	// ErrInvalidStatusTransition defines an error returned when a POA&M item cannot
+	// change from its current status to the requested status.
+	ErrInvalidStatusTransition = errors.New("invalid poam item status transition")
+)
+
+const (
+	// stateSatisfied is the finding target state of findings that close POA&M items.
+	stateSatisfied = "satisfied"
+	// Risk statuses of the risks related to open and closed POA&M items.
+	riskStatusOpen   = "open"
+	riskStatusClosed = "closed"
+	// remediationLifecycle is the lifecycle of the remediations holding milestones and tasks.
+	remediationLifecycle = "planned"
+	// Task types of milestones and remediation tasks.
+	milestoneTaskType = "milestone"
+	actionTaskType    = "action"
+)
+
+// ItemStatus defines the status of a POA&M item.
+type ItemStatus string
+
+const (
+	// ItemStatusOpen is the status of a POA&M item that is being remediated. Items without
+	// a status are open.
+	ItemStatusOpen ItemStatus = "open"
+	// ItemStatusDeferred is the status of a POA&M item whose remediation is postponed.
+	ItemStatusDeferred ItemStatus = "deferred"
+	// ItemStatusClosed is the status of a POA&M item that is remediated.
+	ItemStatusClosed ItemStatus = "closed"
+)
+
+// StatusChange defines a recorded status change of a POA&M item.
+type StatusChange struct {
+	// Status is the status of the item after the change.
+	Status ItemStatus
+	// Time is the time of the change.
+	Time time.Time
+	// Reason is the optional reason for the change.
+	Reason string
+}
+
+type managerOpts struct {
+	now func() time.Time
+}
+
+func (m *managerOpts) defaults() {
+	m.now = time.Now
+}
+
+// ManagerOption defines an option to tune the behavior of the Manager.
+type ManagerOption func(opts *managerOpts)
+
+// WithClock is a ManagerOption that sets the function returning the time of status changes.
+// The default is time.Now.
+func WithClock(now func() time.Time) ManagerOption {
+	return func(opts *managerOpts) {
+		opts.now = now
+	}
+}
+
+// Manager manages the lifecycle of the items of an OSCAL Plan of Action and Milestones, such
+// as one loaded with models.NewPOAM. The POA&M is updated in place.
+//
+// The status of an item and the history of its status changes are recorded in its properties.
+// Milestones and remediation tasks are recorded as tasks of a planned remediation of the first
+// Risk related to the item, which is created if the item has no Risk.
+//
+// A Manager is not safe for concurrent use.
+type Manager struct {
+	poam *oscalTypes.PlanOfActionAndMilestones
+	now  func() time.Time
+	// identifiers generates random UUIDs for the items, risks and tasks added by the Manager.
+	identifiers identifiers.Generator
+}
+
+// NewManager returns a new Manager for the POA&M.
+func NewManager(poam *oscalTypes.PlanOfActionAndMilestones, opts ...ManagerOption) *Manager {
+	options := managerOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Manager{
+		poam: poam,
+		now:  options.now,
+	}
+}
+
+// Open adds an open POA&M item and returns its UUID. A UUID is generated for items without one.
+func (m *Manager) Open(item oscalTypes.PoamItem, reason string) (string, error) {
+	if item.UUID == "" {
+		item.UUID = m.identifiers.UUID()
+	} else if _, err := m.item(item.UUID); err == nil {
+		return "", fmt.Errorf("%w: %s", ErrItemExists, item.UUID)
+	}
+	m.poam.PoamItems = append(m.poam.PoamItems, item)
+	m.setStatus(&m.poam.PoamItems[len(m.poam.PoamItems)-1], ItemStatusOpen, reason)
+	return item.UUID, nil
+}
+
+// Update applies the update to the POA&M item. The status of the item must be changed with
+// Defer, Close and Reopen instead.
+func (m *Manager) Update(uuid string, update func(item *oscalTypes.PoamItem)) error {
+	item, err := m.item(uuid)
+	if err != nil {
+		return err
+	}
+	update(item)
+	return nil
+}
+
+// Defer postpones the remediation of an open or deferred POA&M item until the given time.
+func (m *Manager) Defer(uuid string, until time.Time, reason string) error {
+	item, err := m.transition(uuid, ItemStatusDeferred, ItemStatusOpen, ItemStatusDeferred)
+	if err != nil {
+		return err
+	}
+	m.setStatus(item, ItemStatusDeferred, reason)
+	setProp(item, extensions.POAMItemDeferredUntilProp, until.UTC().Format(time.RFC3339))
+	return nil
+}
+
+// Close closes an open or deferred POA&M item and its related Risks.
+func (m *Manager) Close(uuid string, reason string) error {
+	item, err := m.transition(uuid, ItemStatusClosed, ItemStatusOpen, ItemStatusDeferred)
+	if err != nil {
+		return err
+	}
+	m.setStatus(item, ItemStatusClosed, reason)
+	removeProp(item, extensions.POAMItemDeferredUntilProp)
+	m.setRiskStatus(item, riskStatusOpen, riskStatusClosed)
+	return nil
+}
+
+// Reopen opens a deferred or closed POA&M item and the related Risks that were closed.
+func (m *Manager) Reopen(uuid string, reason string) error {
+	item, err := m.transition(uuid, ItemStatusOpen, ItemStatusDeferred, ItemStatusClosed)
+	if err != nil {
+		return err
+	}
+	m.setStatus(item, ItemStatusOpen, reason)
+	removeProp(item, extensions.POAMItemDeferredUntilProp)
+	m.setRiskStatus(item, riskStatusClosed, riskStatusOpen)
+	return nil
+}
+
+// Status returns the status of the POA&M item.
+func (m *Manager) Status(uuid string) (ItemStatus, error) {
+	item, err := m.item(uuid)
+	if err != nil {
+		return "", err
+	}
+	return itemStatus(*item), nil
+}
+
+// History returns the status changes of the POA&M item in the order they were recorded.
+func (m *Manager) History(uuid string) ([]StatusChange, error) {
+	item, err := m.item(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return itemHistory(*item)
+}
+
+// AddMilestone adds a milestone with a due date to the remediation of the POA&M item and
+// returns the UUID of the milestone task.
+func (m *Manager) AddMilestone(uuid, title string, due time.Time) (string, error) {
+	return m.addTask(uuid, oscalTypes.Task{
+		Title:  title,
+		Type:   milestoneTaskType,
+		Timing: &oscalTypes.EventTiming{OnDate: &oscalTypes.OnDateCondition{Date: due}},
+	})
+}
+
+// AddRemediationTask adds an action task to the remediation of the POA&M item and returns
+// the UUID of the task.
+func (m *Manager) AddRemediationTask(uuid, title, description string) (string, error) {
+	return m.addTask(uuid, oscalTypes.Task{
+		Title:       title,
+		Description: description,
+		Type:        actionTaskType,
+	})
+}
+
+// CloseSatisfied closes the open and deferred POA&M items whose findings are all satisfied
+// in the newest Result of the AssessmentResults that reviews them, and returns the UUIDs of
+// the closed items. The findings of an item are its related Findings in the POA&M and the
+// finding target tracked in its properties. Results that started before the last status
+// change of an item do not close it.
+func (m *Manager) CloseSatisfied(assessmentResults oscalTypes.AssessmentResults) ([]string, error) {
+	type targetState struct {
+		state      string
+		resultUUID string
+		start      time.Time
+	}
+	results := make([]oscalTypes.Result, len(assessmentResults.Results))
+	copy(results, assessmentResults.Results)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Start.Before(results[j].Start)
+	})
+	latest := make(map[string]targetState)
+	for _, result := range results {
+		if result.Findings == nil {
+			continue
+		}
+		for _, finding := range *result.Findings {
+			latest[finding.Target.TargetId] = targetState{state: finding.Target.Status.State, resultUUID: result.UUID, start: result.Start}
+		}
+	}
+
+	var closed []string
+	for i := range m.poam.PoamItems {
+		item := &m.poam.PoamItems[i]
+		if itemStatus(*item) == ItemStatusClosed {
+			continue
+		}
+		targets := m.findingTargets(*item)
+		if len(targets) == 0 {
+			continue
+		}
+		history, err := itemHistory(*item)
+		if err != nil {
+			return closed, err
+		}
+
+		var newest targetState
+		satisfied := true
+		for _, target := range targets {
+			state, ok := latest[target]
+			if !ok || state.state != stateSatisfied {
+				satisfied = false
+				break
+			}
+			if len(history) > 0 && state.start.Before(history[len(history)-1].Time) {
+				satisfied = false
+				break
+			}
+			if !state.start.Before(newest.start) {
+				newest = state
+			}
+		}
+		if !satisfied {
+			continue
+		}
+		if err := m.Close(item.UUID, fmt.Sprintf("Findings satisfied in assessment result %s", newest.resultUUID)); err != nil {
+			return closed, err
+		}
+		closed = append(closed, item.UUID)
+	}
+	return closed, nil
+}
+
+// item returns the POA&M item with the UUID.
+func (m *Manager) item(uuid string) (*oscalTypes.PoamItem, error) {
+	for i := range m.poam.PoamItems {
+		if m.poam.PoamItems[i].UUID == uuid {
+			return &m.poam.PoamItems[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrItemNotFound, uuid)
+}
+
+// transition returns the POA&M item with the UUID if it can change to the status from
+// its current status.
+func (m *Manager) transition(uuid string, to ItemStatus, from ...ItemStatus) (*oscalTypes.PoamItem, error) {
+	item, err := m.item(uuid)
+	if err != nil {
+		return nil, err
+	}
+	current := itemStatus(*item)
+	for _, status := range from {
+		if current == status {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: item %s from %s to %s", ErrInvalidStatusTransition, uuid, current, to)
+}
+
+// setStatus sets the status of the item and records the status change.
+func (m *Manager) setStatus(item *oscalTypes.PoamItem, status ItemStatus, reason string) {
+	setProp(item, extensions.POAMItemStatusProp, string(status))
+	remarks := m.now().UTC().Format(time.RFC3339Nano)
+	if reason != "" {
+		remarks += " " + reason
+	}
+	props := append(slices.Clone(propsOf(item)), oscalTypes.Property{
+		Name:    extensions.POAMItemStatusChangeProp,
+		Value:   string(status),
+		Ns:      extensions.TrestleNameSpace,
+		Remarks: remarks,
+	})
+	item.Props = &props
+}
+
+// setRiskStatus changes the status of the Risks related to the item from one status to another.
+func (m *Manager) setRiskStatus(item *oscalTypes.PoamItem, from, to string) {
+	if item.RelatedRisks == nil || m.poam.Risks == nil {
+		return
+	}
+	related := set.New[string]()
+	for _, risk := range *item.RelatedRisks {
+		related.Add(risk.RiskUuid)
+	}
+	for i := range *m.poam.Risks {
+		risk := &(*m.poam.Risks)[i]
+		if related.Has(risk.UUID) && risk.Status == from {
+			risk.Status = to
+		}
+	}
+}
+
+// addTask adds the task to the planned remediation of the first Risk related to the item.
+func (m *Manager) addTask(uuid string, task oscalTypes.Task) (string, error) {
+	item, err := m.item(uuid)
+	if err != nil {
+		return "", err
+	}
+	remediation := m.remediation(item)
+	task.UUID = m.identifiers.UUID()
+	if remediation.Tasks == nil {
+		remediation.Tasks = &[]oscalTypes.Task{}
+	}
+	*remediation.Tasks = append(*remediation.Tasks, task)
+	return task.UUID, nil
+}
+
+// remediation returns the planned remediation of the first Risk related to the item. The Risk
+// and remediation are created if they do not exist.
+func (m *Manager) remediation(item *oscalTypes.PoamItem) *oscalTypes.Response {
+	if m.poam.Risks == nil {
+		m.poam.Risks = &[]oscalTypes.Risk{}
+	}
+	risks := *m.poam.Risks
+
+	var risk *oscalTypes.Risk
+	if item.RelatedRisks != nil {
+		for _, related := range *item.RelatedRisks {
+			for i := range risks {
+				if risks[i].UUID == related.RiskUuid {
+					risk = &risks[i]
+					break
+				}
+			}
+			if risk != nil {
+				break
+			}
+		}
+	}
+	if risk == nil {
+		*m.poam.Risks = append(*m.poam.Risks, oscalTypes.Risk{
+			UUID:        m.identifiers.UUID(),
+			Title:       item.Title,
+			Description: item.Description,
+			Statement:   item.Description,
+			Status:      riskStatusOpen,
+		})
+		risk = &(*m.poam.Risks)[len(*m.poam.Risks)-1]
+		if item.RelatedRisks == nil {
+			item.RelatedRisks = &[]oscalTypes.AssociatedRisk{}
+		}
+		*item.RelatedRisks = append(*item.RelatedRisks, oscalTypes.AssociatedRisk{RiskUuid: risk.UUID})
+	}
+
+	if risk.Remediations == nil {
+		risk.Remediations = &[]oscalTypes.Response{}
+	}
+	for i := range *risk.Remediations {
+		if (*risk.Remediations)[i].Lifecycle == remediationLifecycle {
+			return &(*risk.Remediations)[i]
+		}
+	}
+	*risk.Remediations = append(*risk.Remediations, oscalTypes.Response{
+		UUID:        m.identifiers.UUID(),
+		Lifecycle:   remediationLifecycle,
+		Title:       fmt.Sprintf("Remediation For %q", item.Title),
+		Description: fmt.Sprintf("Planned remediation for POA&M item %q", item.Title),
+	})
+	return &(*risk.Remediations)[len(*risk.Remediations)-1]
+}
+
+// findingTargets returns the targets of the findings of the item.
+func (m *Manager) findingTargets(item oscalTypes.PoamItem) []string {
+	targets := set.New[string]()
+	var ordered []string
+	add := func(target string) {
+		if target != "" && !targets.Has(target) {
+			targets.Add(target)
+			ordered = append(ordered, target)
+		}
+	}
+	if prop, found := extensions.GetTrestleProp(extensions.FindingTargetProp, propsOf(&item)); found {
+		add(prop.Value)
+	}
+	if item.RelatedFindings != nil && m.poam.Findings != nil {
+		for _, related := range *item.RelatedFindings {
+			for _, finding := range *m.poam.Findings {
+				if finding.UUID == related.FindingUuid {
+					add(finding.Target.TargetId)
+				}
+			}
+		}
+	}
+	return ordered
+}
+
+// itemStatus returns the status of the item. Items without a status are open.
+func itemStatus(item oscalTypes.PoamItem) ItemStatus {
+	if prop, found := extensions.GetTrestleProp(extensions.POAMItemStatusProp, propsOf(&item)); found {
+		return ItemStatus(prop.Value)
+	}
+	return ItemStatusOpen
+}
+
+// itemHistory returns the status changes recorded in the properties of the item.
+func itemHistory(item oscalTypes.PoamItem) ([]StatusChange, error) {
+	var history []StatusChange
+	for _, prop := range extensions.FindAllProps(propsOf(&item), extensions.WithName(extensions.POAMItemStatusChangeProp)) {
+		timestamp, reason, _ := strings.Cut(prop.Remarks, " ")
+		changed, err := time.Parse(time.RFC3339Nano, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("item %s: invalid status change time %q: %w", item.UUID, timestamp, err)
+		}
+		history = append(history, StatusChange{Status: ItemStatus(prop.Value), Time: changed, Reason: reason})
+	}
+	return history, nil
+}
+
+func propsOf(item *oscalTypes.PoamItem) []oscalTypes.Property {
+	if item.Props == nil {
+		return nil
+	}
+	return *item.Props
+}
+
+// setProp replaces the trestle property with the name on the item.
+func setProp(item *oscalTypes.PoamItem, name, value string) {
+	removeProp(item, name)
+	props := append(slices.Clone(propsOf(item)), oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace})
+	item.Props = &props
+}
+
+// removeProp removes the trestle properties with the name from the item.
+func removeProp(item *oscalTypes.PoamItem, name string) {
+	var props []oscalTypes.Property
+	for _, prop := range propsOf(item) {
+		if prop.Name == name && strings.Contains(prop.Ns, extensions.TrestleNameSpace) {
+			continue
+		}
+		props = append(props, prop)
+	}
+	if len(props) == 0 {
+		item.Props = nil
+		return
+	}
+	item.Props = &props
+}
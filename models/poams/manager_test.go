@@ -0,0 +1,341 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+const (
+	testItemUUID    = "6b2e0c1f-6f0b-4c47-9d2c-3f1a0b9c8e71"
+	testRiskUUID    = "0e4f6a57-3b0c-4a53-8d1e-5c2b7f9a1d22"
+	testFindingUUID = "9a7c3e21-4d5b-4f68-a0b1-c2d3e4f5a6b7"
+)
+
+// testPOAM returns a POA&M with an item tracking control ex-1 as generated from
+// assessment results.
+func testPOAM() *oscalTypes.PlanOfActionAndMilestones {
+	metadata := models.NewSampleMetadata()
+	return &oscalTypes.PlanOfActionAndMilestones{
+		UUID:     "2f1d0c9b-8a7e-4d6c-9b5a-4f3e2d1c0b9a",
+		Metadata: metadata,
+		PoamItems: []oscalTypes.PoamItem{
+			{
+				UUID:            testItemUUID,
+				Title:           `Finding For "ex-1"`,
+				Description:     `OSCAL Assessment Finding For Control "ex-1"`,
+				Props:           &[]oscalTypes.Property{{Name: extensions.FindingTargetProp, Value: "ex-1", Ns: extensions.TrestleNameSpace}},
+				RelatedFindings: &[]oscalTypes.RelatedFinding{{FindingUuid: testFindingUUID}},
+				RelatedRisks:    &[]oscalTypes.AssociatedRisk{{RiskUuid: testRiskUUID}},
+			},
+		},
+		Findings: &[]oscalTypes.Finding{
+			{
+				UUID:        testFindingUUID,
+				Title:       `Finding For "ex-1"`,
+				Description: `OSCAL Assessment Finding For Control "ex-1"`,
+				Target: oscalTypes.FindingTarget{
+					Type: "objective-id", TargetId: "ex-1",
+					Status: oscalTypes.ObjectiveStatus{State: "not-satisfied", Reason: "fail"},
+				},
+				RelatedRisks: &[]oscalTypes.AssociatedRisk{{RiskUuid: testRiskUUID}},
+			},
+		},
+		Risks: &[]oscalTypes.Risk{
+			{UUID: testRiskUUID, Title: "Rule 1", Description: "Rule 1", Statement: "Rule 1 failed.", Status: "open"},
+		},
+	}
+}
+
+// testClock returns a clock that advances by one minute on each call.
+func testClock() func() time.Time {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+}
+
+func TestManager_Lifecycle(t *testing.T) {
+	poam := testPOAM()
+	manager := NewManager(poam, WithClock(testClock()))
+
+	status, err := manager.Status(testItemUUID)
+	require.NoError(t, err)
+	require.Equal(t, ItemStatusOpen, status)
+
+	until := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, manager.Defer(testItemUUID, until, "waiting for vendor patch"))
+	status, err = manager.Status(testItemUUID)
+	require.NoError(t, err)
+	require.Equal(t, ItemStatusDeferred, status)
+	deferredUntil, found := extensions.GetTrestleProp(extensions.POAMItemDeferredUntilProp, *poam.PoamItems[0].Props)
+	require.True(t, found)
+	require.Equal(t, "2025-06-01T00:00:00Z", deferredUntil.Value)
+
+	require.NoError(t, manager.Close(testItemUUID, "patched"))
+	require.Equal(t, "closed", (*poam.Risks)[0].Status)
+	_, found = extensions.GetTrestleProp(extensions.POAMItemDeferredUntilProp, *poam.PoamItems[0].Props)
+	require.False(t, found)
+
+	require.NoError(t, manager.Reopen(testItemUUID, "regression"))
+	require.Equal(t, "open", (*poam.Risks)[0].Status)
+
+	require.NoError(t, manager.Update(testItemUUID, func(item *oscalTypes.PoamItem) {
+		item.Remarks = "Tracked by the platform team."
+	}))
+	require.Equal(t, "Tracked by the platform team.", poam.PoamItems[0].Remarks)
+
+	history, err := manager.History(testItemUUID)
+	require.NoError(t, err)
+	require.Equal(t, []StatusChange{
+		{Status: ItemStatusDeferred, Time: time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC), Reason: "waiting for vendor patch"},
+		{Status: ItemStatusClosed, Time: time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC), Reason: "patched"},
+		{Status: ItemStatusOpen, Time: time.Date(2025, 1, 1, 0, 3, 0, 0, time.UTC), Reason: "regression"},
+	}, history)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: poam}))
+}
+
+func TestManager_Open(t *testing.T) {
+	poam := testPOAM()
+	manager := NewManager(poam)
+
+	uuid, err := manager.Open(oscalTypes.PoamItem{Title: "Manual review", Description: "Review the firewall rules"}, "found in audit")
+	require.NoError(t, err)
+	require.NotEmpty(t, uuid)
+	require.Len(t, poam.PoamItems, 2)
+	status, err := manager.Status(uuid)
+	require.NoError(t, err)
+	require.Equal(t, ItemStatusOpen, status)
+	history, err := manager.History(uuid)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "found in audit", history[0].Reason)
+
+	_, err = manager.Open(oscalTypes.PoamItem{UUID: testItemUUID, Title: "Duplicate"}, "")
+	require.ErrorIs(t, err, ErrItemExists)
+}
+
+func TestManager_InvalidTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(manager *Manager) error
+		change   func(manager *Manager) error
+		expError error
+	}{
+		{
+			name:     "Invalid/ReopenOpenItem",
+			change:   func(manager *Manager) error { return manager.Reopen(testItemUUID, "") },
+			expError: ErrInvalidStatusTransition,
+		},
+		{
+			name:     "Invalid/CloseClosedItem",
+			setup:    func(manager *Manager) error { return manager.Close(testItemUUID, "") },
+			change:   func(manager *Manager) error { return manager.Close(testItemUUID, "") },
+			expError: ErrInvalidStatusTransition,
+		},
+		{
+			name:     "Invalid/DeferClosedItem",
+			setup:    func(manager *Manager) error { return manager.Close(testItemUUID, "") },
+			change:   func(manager *Manager) error { return manager.Defer(testItemUUID, time.Now(), "") },
+			expError: ErrInvalidStatusTransition,
+		},
+		{
+			name:     "Invalid/ItemNotFound",
+			change:   func(manager *Manager) error { return manager.Close("missing", "") },
+			expError: ErrItemNotFound,
+		},
+		{
+			name: "Invalid/UpdateItemNotFound",
+			change: func(manager *Manager) error {
+				return manager.Update("missing", func(*oscalTypes.PoamItem) {})
+			},
+			expError: ErrItemNotFound,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			manager := NewManager(testPOAM())
+			if c.setup != nil {
+				require.NoError(t, c.setup(manager))
+			}
+			require.ErrorIs(t, c.change(manager), c.expError)
+		})
+	}
+}
+
+func TestManager_MilestonesAndTasks(t *testing.T) {
+	poam := testPOAM()
+	manager := NewManager(poam)
+
+	due := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	milestoneUUID, err := manager.AddMilestone(testItemUUID, "Patch staging", due)
+	require.NoError(t, err)
+	taskUUID, err := manager.AddRemediationTask(testItemUUID, "Rotate keys", "Rotate the etcd encryption keys")
+	require.NoError(t, err)
+
+	// The tasks are added to a single planned remediation of the related risk.
+	risk := (*poam.Risks)[0]
+	require.NotNil(t, risk.Remediations)
+	require.Len(t, *risk.Remediations, 1)
+	remediation := (*risk.Remediations)[0]
+	require.Equal(t, "planned", remediation.Lifecycle)
+	require.Len(t, *remediation.Tasks, 2)
+	milestone := (*remediation.Tasks)[0]
+	require.Equal(t, milestoneUUID, milestone.UUID)
+	require.Equal(t, "milestone", milestone.Type)
+	require.Equal(t, due, milestone.Timing.OnDate.Date)
+	task := (*remediation.Tasks)[1]
+	require.Equal(t, taskUUID, task.UUID)
+	require.Equal(t, "action", task.Type)
+
+	// Items without a risk get one for their remediation.
+	itemUUID, err := manager.Open(oscalTypes.PoamItem{Title: "Manual review", Description: "Review the firewall rules"}, "")
+	require.NoError(t, err)
+	_, err = manager.AddMilestone(itemUUID, "Review complete", due)
+	require.NoError(t, err)
+	require.Len(t, *poam.Risks, 2)
+	require.Equal(t, &[]oscalTypes.AssociatedRisk{{RiskUuid: (*poam.Risks)[1].UUID}}, poam.PoamItems[1].RelatedRisks)
+
+	_, err = manager.AddRemediationTask("missing", "Rotate keys", "")
+	require.ErrorIs(t, err, ErrItemNotFound)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: poam}))
+}
+
+func TestManager_CloseSatisfied(t *testing.T) {
+	result := func(uuid string, start time.Time, state string) oscalTypes.Result {
+		return oscalTypes.Result{
+			UUID:  uuid,
+			Start: start,
+			Findings: &[]oscalTypes.Finding{
+				{Target: oscalTypes.FindingTarget{TargetId: "ex-1", Status: oscalTypes.ObjectiveStatus{State: state}}},
+			},
+		}
+	}
+	opened := time.Date(2025, 1, 1, 0, 1, 0, 500000000, time.UTC)
+
+	tests := []struct {
+		name       string
+		results    []oscalTypes.Result
+		wantClosed []string
+	}{
+		{
+			name:       "Valid/Satisfied",
+			results:    []oscalTypes.Result{result("result-1", opened.Add(time.Hour), "satisfied")},
+			wantClosed: []string{testItemUUID},
+		},
+		{
+			name: "Valid/SatisfiedInNewestResult",
+			results: []oscalTypes.Result{
+				result("result-2", opened.Add(2*time.Hour), "satisfied"),
+				result("result-1", opened.Add(time.Hour), "not-satisfied"),
+			},
+			wantClosed: []string{testItemUUID},
+		},
+		{
+			name: "Valid/NotSatisfiedInNewestResult",
+			results: []oscalTypes.Result{
+				result("result-1", opened.Add(time.Hour), "satisfied"),
+				result("result-2", opened.Add(2*time.Hour), "not-satisfied"),
+			},
+		},
+		{
+			name:    "Valid/ResultBeforeStatusChange",
+			results: []oscalTypes.Result{result("result-1", opened.Add(-time.Hour), "satisfied")},
+		},
+		{
+			name:    "Valid/ResultBeforeStatusChangeInSameSecond",
+			results: []oscalTypes.Result{result("result-1", opened.Add(-300*time.Millisecond), "satisfied")},
+		},
+		{
+			name: "Valid/NotReviewed",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			poam := testPOAM()
+			manager := NewManager(poam, WithClock(testClock()))
+			// The item was opened before the results.
+			poam.PoamItems[0].Props = &[]oscalTypes.Property{
+				{Name: extensions.FindingTargetProp, Value: "ex-1", Ns: extensions.TrestleNameSpace},
+				{Name: extensions.POAMItemStatusChangeProp, Value: "open", Ns: extensions.TrestleNameSpace, Remarks: opened.Format(time.RFC3339Nano)},
+			}
+
+			closed, err := manager.CloseSatisfied(oscalTypes.AssessmentResults{Results: c.results})
+			require.NoError(t, err)
+			require.Equal(t, c.wantClosed, closed)
+
+			status, err := manager.Status(testItemUUID)
+			require.NoError(t, err)
+			if c.wantClosed == nil {
+				require.Equal(t, ItemStatusOpen, status)
+				return
+			}
+			require.Equal(t, ItemStatusClosed, status)
+			history, err := manager.History(testItemUUID)
+			require.NoError(t, err)
+			require.Contains(t, history[len(history)-1].Reason, "result-")
+		})
+	}
+}
+
+func TestManager_StatusChangeTime(t *testing.T) {
+	poam := testPOAM()
+	changed := time.Date(2025, 1, 1, 0, 1, 0, 750000000, time.UTC)
+	manager := NewManager(poam, WithClock(func() time.Time { return changed }))
+
+	uuid, err := manager.Open(oscalTypes.PoamItem{
+		Title:       "Manual review",
+		Description: "Review the firewall rules",
+		Props:       &[]oscalTypes.Property{{Name: extensions.FindingTargetProp, Value: "ex-1", Ns: extensions.TrestleNameSpace}},
+	}, "")
+	require.NoError(t, err)
+	history, err := manager.History(uuid)
+	require.NoError(t, err)
+	require.Equal(t, []StatusChange{{Status: ItemStatusOpen, Time: changed}}, history)
+
+	// A result that started earlier in the same second does not close the item.
+	require.NoError(t, manager.Close(testItemUUID, ""))
+	closed, err := manager.CloseSatisfied(oscalTypes.AssessmentResults{
+		Results: []oscalTypes.Result{
+			{
+				UUID:     "result-1",
+				Start:    changed.Add(-500 * time.Millisecond),
+				Findings: &[]oscalTypes.Finding{{Target: oscalTypes.FindingTarget{TargetId: "ex-1", Status: oscalTypes.ObjectiveStatus{State: "satisfied"}}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, closed)
+}
+
+func TestManager_PropsNotShared(t *testing.T) {
+	poam := testPOAM()
+	manager := NewManager(poam)
+
+	// The properties of the opened item share spare capacity with the caller.
+	props := make([]oscalTypes.Property, 1, 4)
+	props[0] = oscalTypes.Property{Name: extensions.FindingTargetProp, Value: "ex-2", Ns: extensions.TrestleNameSpace}
+	uuid, err := manager.Open(oscalTypes.PoamItem{Title: "Manual review", Description: "Review the firewall rules", Props: &props}, "")
+	require.NoError(t, err)
+	require.NoError(t, manager.Defer(uuid, time.Now(), ""))
+
+	require.Len(t, props, 1)
+	require.Equal(t, make([]oscalTypes.Property, 3), props[1:cap(props)])
+	require.Len(t, *poam.PoamItems[1].Props, 5)
+}
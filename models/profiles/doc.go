@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package profiles defines logic for resolving OSCAL Profiles against their imported
+// Catalogs and Profiles to produce a resolved profile catalog.
+package profiles
@@ -0,0 +1,99 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+var (
+	_ Loader  = (*FileLoader)(nil)
+	_ Decoder = (*FileLoader)(nil)
+)
+
+// Loader defines methods for retrieving the OSCAL models referenced by an import href.
+type Loader interface {
+	// Load returns the OSCAL models found at the given href.
+	Load(ctx context.Context, href string) (oscalTypes.OscalModels, error)
+}
+
+// Decoder is implemented by Loaders that decode content embedded in a document, such as
+// a base64 back-matter resource, with the same validation as loaded models.
+type Decoder interface {
+	// Decode returns the OSCAL models for the embedded content.
+	Decode(ctx context.Context, content []byte) (oscalTypes.OscalModels, error)
+}
+
+// FileLoader implements the Loader interface for OSCAL models stored
+// on the local filesystem.
+type FileLoader struct {
+	baseDir   string
+	validator validation.Validator
+}
+
+// NewFileLoader returns a new FileLoader that resolves relative hrefs against
+// the given base directory and validates loaded models with the given validator.
+func NewFileLoader(baseDir string, validator validation.Validator) *FileLoader {
+	return &FileLoader{
+		baseDir:   baseDir,
+		validator: validator,
+	}
+}
+
+// Load returns the OSCAL models for a relative path, absolute path, or `file://` URL.
+// JSON, YAML and XML documents are supported.
+func (f *FileLoader) Load(_ context.Context, href string) (oscalTypes.OscalModels, error) {
+	path, err := f.path(href)
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return oscalTypes.OscalModels{}, fmt.Errorf("failed to load %q: %w", href, err)
+	}
+	defer file.Close()
+
+	oscalModels, err := models.Decode(file, models.FormatFromPath(path), f.validator)
+	if err != nil {
+		return oscalTypes.OscalModels{}, fmt.Errorf("failed to load %q: %w", href, err)
+	}
+	return oscalModels, nil
+}
+
+// Decode returns the OSCAL models for embedded content in any supported format.
+func (f *FileLoader) Decode(_ context.Context, content []byte) (oscalTypes.OscalModels, error) {
+	return models.Decode(bytes.NewReader(content), models.FormatUnknown, f.validator)
+}
+
+// path returns the filesystem path for the given href.
+func (f *FileLoader) path(href string) (string, error) {
+	if strings.HasPrefix(href, "file://") {
+		parsed, err := url.Parse(href)
+		if err != nil {
+			return "", fmt.Errorf("invalid href %q: %w", href, err)
+		}
+		return parsed.Path, nil
+	}
+	if strings.Contains(href, "://") {
+		return "", fmt.Errorf("href %q: %w", href, ErrUnsupportedHref)
+	}
+	if filepath.IsAbs(href) {
+		return href, nil
+	}
+	return filepath.Join(f.baseDir, href), nil
+}
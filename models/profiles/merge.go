@@ -0,0 +1,265 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"sort"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+const (
+	orderAscending  = "ascending"
+	orderDescending = "descending"
+)
+
+// mergeCatalogs combines the selections from each import into a single Catalog
+// based on the structuring directive of the profile merge.
+//
+// When the same control or parameter is imported more than once, the first
+// occurrence is used. Without a structuring directive, the controls are
+// output without groups.
+func mergeCatalogs(merge *oscalTypes.Merge, selections []oscalTypes.Catalog) oscalTypes.Catalog {
+	var merged oscalTypes.Catalog
+	switch {
+	case merge != nil && merge.AsIs:
+		merged = mergeAsIs(selections)
+	case merge != nil && merge.Custom != nil:
+		merged = mergeCustom(*merge.Custom, selections)
+	default:
+		merged = mergeFlat(selections)
+	}
+	merged.BackMatter = mergeBackMatter(selections)
+	return merged
+}
+
+// mergeAsIs retains the group structure of the imported catalogs. Groups with the
+// same ID are combined.
+func mergeAsIs(selections []oscalTypes.Catalog) oscalTypes.Catalog {
+	var params []oscalTypes.Parameter
+	var controls []oscalTypes.Control
+	var groups []oscalTypes.Group
+	seenControls := set.New[string]()
+	seenParams := set.New[string]()
+	for _, selection := range selections {
+		if selection.Params != nil {
+			params = appendParams(params, *selection.Params, seenParams)
+		}
+		if selection.Controls != nil {
+			controls = appendControls(controls, *selection.Controls, seenControls)
+		}
+		if selection.Groups != nil {
+			groups = mergeGroups(groups, *selection.Groups, seenControls)
+		}
+	}
+	return oscalTypes.Catalog{
+		Params:   modelutils.NilIfEmpty(&params),
+		Controls: modelutils.NilIfEmpty(&controls),
+		Groups:   modelutils.NilIfEmpty(&groups),
+	}
+}
+
+// mergeGroups adds the incoming groups to the existing groups, combining
+// the controls and subgroups of groups with the same ID.
+func mergeGroups(existing []oscalTypes.Group, incoming []oscalTypes.Group, seenControls set.Set[string]) []oscalTypes.Group {
+	for _, group := range incoming {
+		idx := -1
+		if group.ID != "" {
+			for i := range existing {
+				if existing[i].ID == group.ID {
+					idx = i
+					break
+				}
+			}
+		}
+
+		var controls []oscalTypes.Control
+		var subgroups []oscalTypes.Group
+		if idx >= 0 {
+			if existing[idx].Controls != nil {
+				controls = *existing[idx].Controls
+			}
+			if existing[idx].Groups != nil {
+				subgroups = *existing[idx].Groups
+			}
+		}
+		if group.Controls != nil {
+			controls = appendControls(controls, *group.Controls, seenControls)
+		}
+		if group.Groups != nil {
+			subgroups = mergeGroups(subgroups, *group.Groups, seenControls)
+		}
+
+		if idx >= 0 {
+			existing[idx].Controls = modelutils.NilIfEmpty(&controls)
+			existing[idx].Groups = modelutils.NilIfEmpty(&subgroups)
+			continue
+		}
+		group.Controls = modelutils.NilIfEmpty(&controls)
+		group.Groups = modelutils.NilIfEmpty(&subgroups)
+		existing = append(existing, group)
+	}
+	return existing
+}
+
+// mergeFlat removes all grouping from the imported catalogs. Group parameters
+// are retained at the catalog level.
+func mergeFlat(selections []oscalTypes.Catalog) oscalTypes.Catalog {
+	params, controls := flatten(selections)
+	return oscalTypes.Catalog{
+		Params:   modelutils.NilIfEmpty(&params),
+		Controls: modelutils.NilIfEmpty(&controls),
+	}
+}
+
+// mergeCustom structures the imported controls with the groups and insert-controls
+// directives from the custom grouping. Controls that are not inserted are dropped.
+func mergeCustom(custom oscalTypes.CustomGrouping, selections []oscalTypes.Catalog) oscalTypes.Catalog {
+	params, pool := flatten(selections)
+	merged := oscalTypes.Catalog{
+		Params: modelutils.NilIfEmpty(&params),
+	}
+	if custom.InsertControls != nil {
+		controls := insertControls(*custom.InsertControls, pool)
+		merged.Controls = modelutils.NilIfEmpty(&controls)
+	}
+	if custom.Groups != nil {
+		groups := customGroups(*custom.Groups, pool)
+		merged.Groups = modelutils.NilIfEmpty(&groups)
+	}
+	return merged
+}
+
+func customGroups(groupings []oscalTypes.CustomGroupingGroup, pool []oscalTypes.Control) []oscalTypes.Group {
+	var groups []oscalTypes.Group
+	for _, custom := range groupings {
+		group := oscalTypes.Group{
+			Class:  custom.Class,
+			ID:     custom.ID,
+			Links:  custom.Links,
+			Params: custom.Params,
+			Parts:  custom.Parts,
+			Props:  custom.Props,
+			Title:  custom.Title,
+		}
+		if custom.InsertControls != nil {
+			controls := insertControls(*custom.InsertControls, pool)
+			group.Controls = modelutils.NilIfEmpty(&controls)
+		}
+		if custom.Groups != nil {
+			subgroups := customGroups(*custom.Groups, pool)
+			group.Groups = modelutils.NilIfEmpty(&subgroups)
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// insertControls returns the controls from the pool selected by the insert-controls directives.
+func insertControls(directives []oscalTypes.InsertControls, pool []oscalTypes.Control) []oscalTypes.Control {
+	var inserted []oscalTypes.Control
+	for _, directive := range directives {
+		var include, exclude []oscalTypes.SelectControlById
+		if directive.IncludeControls != nil {
+			include = *directive.IncludeControls
+		}
+		if directive.ExcludeControls != nil {
+			exclude = *directive.ExcludeControls
+		}
+		selected := selectedIDs(pool, directive.IncludeAll != nil, include, exclude)
+		controls := filterControls(pool, selected)
+
+		switch directive.Order {
+		case orderAscending:
+			sort.SliceStable(controls, func(i, j int) bool { return controls[i].ID < controls[j].ID })
+		case orderDescending:
+			sort.SliceStable(controls, func(i, j int) bool { return controls[i].ID > controls[j].ID })
+		}
+		inserted = append(inserted, controls...)
+	}
+	return inserted
+}
+
+// flatten returns all parameters and controls from the selections without grouping.
+func flatten(selections []oscalTypes.Catalog) ([]oscalTypes.Parameter, []oscalTypes.Control) {
+	var params []oscalTypes.Parameter
+	var controls []oscalTypes.Control
+	seenControls := set.New[string]()
+	seenParams := set.New[string]()
+	var walkGroups func(groups []oscalTypes.Group)
+	walkGroups = func(groups []oscalTypes.Group) {
+		for _, group := range groups {
+			if group.Params != nil {
+				params = appendParams(params, *group.Params, seenParams)
+			}
+			if group.Controls != nil {
+				controls = appendControls(controls, *group.Controls, seenControls)
+			}
+			if group.Groups != nil {
+				walkGroups(*group.Groups)
+			}
+		}
+	}
+	for _, selection := range selections {
+		if selection.Params != nil {
+			params = appendParams(params, *selection.Params, seenParams)
+		}
+		if selection.Controls != nil {
+			controls = appendControls(controls, *selection.Controls, seenControls)
+		}
+		if selection.Groups != nil {
+			walkGroups(*selection.Groups)
+		}
+	}
+	return params, controls
+}
+
+func appendControls(controls []oscalTypes.Control, incoming []oscalTypes.Control, seen set.Set[string]) []oscalTypes.Control {
+	for _, control := range incoming {
+		if seen.Has(control.ID) {
+			continue
+		}
+		seen.Add(control.ID)
+		controls = append(controls, control)
+	}
+	return controls
+}
+
+func appendParams(params []oscalTypes.Parameter, incoming []oscalTypes.Parameter, seen set.Set[string]) []oscalTypes.Parameter {
+	for _, param := range incoming {
+		if seen.Has(param.ID) {
+			continue
+		}
+		seen.Add(param.ID)
+		params = append(params, param)
+	}
+	return params
+}
+
+// mergeBackMatter combines the back-matter resources of all selections.
+func mergeBackMatter(selections []oscalTypes.Catalog) *oscalTypes.BackMatter {
+	var resources []oscalTypes.Resource
+	seen := set.New[string]()
+	for _, selection := range selections {
+		if selection.BackMatter == nil || selection.BackMatter.Resources == nil {
+			continue
+		}
+		for _, resource := range *selection.BackMatter.Resources {
+			if seen.Has(resource.UUID) {
+				continue
+			}
+			seen.Add(resource.UUID)
+			resources = append(resources, resource)
+		}
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	return &oscalTypes.BackMatter{Resources: &resources}
+}
@@ -0,0 +1,392 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+// Addition positions defined by OSCAL
+const (
+	positionBefore   = "before"
+	positionAfter    = "after"
+	positionStarting = "starting"
+	positionEnding   = "ending"
+)
+
+// Item names used to scope removals
+const (
+	itemParam = "param"
+	itemProp  = "prop"
+	itemLink  = "link"
+	itemPart  = "part"
+)
+
+// modifyCatalog applies the parameter settings and alterations of a profile to the
+// merged catalog. Any slices that are modified are replaced so the imported models
+// are not altered.
+func modifyCatalog(catalog *oscalTypes.Catalog, modify *oscalTypes.Modify) error {
+	if modify == nil {
+		return nil
+	}
+
+	if modify.SetParameters != nil {
+		settings := make(map[string]oscalTypes.ParameterSetting, len(*modify.SetParameters))
+		for _, setting := range *modify.SetParameters {
+			settings[setting.ParamId] = setting
+		}
+		catalog.Params = setParams(catalog.Params, settings)
+		if catalog.Groups != nil {
+			groups := setGroupParams(*catalog.Groups, settings)
+			catalog.Groups = &groups
+		}
+		if catalog.Controls != nil {
+			controls := setControlParams(*catalog.Controls, settings)
+			catalog.Controls = &controls
+		}
+	}
+
+	if modify.Alters != nil {
+		for _, alteration := range *modify.Alters {
+			control, found := findControl(catalog, alteration.ControlId)
+			if !found {
+				return fmt.Errorf("alteration for control %q: %w", alteration.ControlId, ErrControlNotFound)
+			}
+			if alteration.Removes != nil {
+				for _, removal := range *alteration.Removes {
+					removeFromControl(control, removal)
+				}
+			}
+			if alteration.Adds != nil {
+				for _, addition := range *alteration.Adds {
+					if err := addToControl(control, addition); err != nil {
+						return fmt.Errorf("alteration for control %q: %w", alteration.ControlId, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func setGroupParams(groups []oscalTypes.Group, settings map[string]oscalTypes.ParameterSetting) []oscalTypes.Group {
+	updated := make([]oscalTypes.Group, len(groups))
+	copy(updated, groups)
+	for i := range updated {
+		updated[i].Params = setParams(updated[i].Params, settings)
+		if updated[i].Controls != nil {
+			controls := setControlParams(*updated[i].Controls, settings)
+			updated[i].Controls = &controls
+		}
+		if updated[i].Groups != nil {
+			subgroups := setGroupParams(*updated[i].Groups, settings)
+			updated[i].Groups = &subgroups
+		}
+	}
+	return updated
+}
+
+func setControlParams(controls []oscalTypes.Control, settings map[string]oscalTypes.ParameterSetting) []oscalTypes.Control {
+	updated := make([]oscalTypes.Control, len(controls))
+	copy(updated, controls)
+	for i := range updated {
+		updated[i].Params = setParams(updated[i].Params, settings)
+		if updated[i].Controls != nil {
+			children := setControlParams(*updated[i].Controls, settings)
+			updated[i].Controls = &children
+		}
+	}
+	return updated
+}
+
+// setParams returns a copy of the parameters with any matching parameter settings applied.
+func setParams(params *[]oscalTypes.Parameter, settings map[string]oscalTypes.ParameterSetting) *[]oscalTypes.Parameter {
+	if params == nil {
+		return nil
+	}
+	updated := make([]oscalTypes.Parameter, len(*params))
+	copy(updated, *params)
+	for i := range updated {
+		setting, ok := settings[updated[i].ID]
+		if !ok {
+			continue
+		}
+		applyParameterSetting(&updated[i], setting)
+	}
+	return &updated
+}
+
+// applyParameterSetting replaces parameter fields with the fields populated in the setting.
+// Properties and links are appended to the existing values.
+func applyParameterSetting(param *oscalTypes.Parameter, setting oscalTypes.ParameterSetting) {
+	if setting.Class != "" {
+		param.Class = setting.Class
+	}
+	if setting.DependsOn != "" {
+		param.DependsOn = setting.DependsOn
+	}
+	if setting.Label != "" {
+		param.Label = setting.Label
+	}
+	if setting.Usage != "" {
+		param.Usage = setting.Usage
+	}
+	if setting.Constraints != nil {
+		param.Constraints = setting.Constraints
+	}
+	if setting.Guidelines != nil {
+		param.Guidelines = setting.Guidelines
+	}
+	if setting.Select != nil {
+		param.Select = setting.Select
+	}
+	if setting.Values != nil {
+		param.Values = setting.Values
+	}
+	if setting.Props != nil {
+		param.Props = appendAll(param.Props, *setting.Props)
+	}
+	if setting.Links != nil {
+		param.Links = appendAll(param.Links, *setting.Links)
+	}
+}
+
+// findControl returns a pointer to the control with the given ID in the catalog.
+func findControl(catalog *oscalTypes.Catalog, controlID string) (*oscalTypes.Control, bool) {
+	if catalog.Controls != nil {
+		if control, found := findInControls(*catalog.Controls, controlID); found {
+			return control, true
+		}
+	}
+	if catalog.Groups != nil {
+		return findInGroups(*catalog.Groups, controlID)
+	}
+	return nil, false
+}
+
+func findInGroups(groups []oscalTypes.Group, controlID string) (*oscalTypes.Control, bool) {
+	for i := range groups {
+		if groups[i].Controls != nil {
+			if control, found := findInControls(*groups[i].Controls, controlID); found {
+				return control, true
+			}
+		}
+		if groups[i].Groups != nil {
+			if control, found := findInGroups(*groups[i].Groups, controlID); found {
+				return control, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func findInControls(controls []oscalTypes.Control, controlID string) (*oscalTypes.Control, bool) {
+	for i := range controls {
+		if controls[i].ID == controlID {
+			return &controls[i], true
+		}
+		if controls[i].Controls != nil {
+			if control, found := findInControls(*controls[i].Controls, controlID); found {
+				return control, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// removeFromControl removes all params, props, links, and parts from the control that
+// match every criterion set on the removal.
+func removeFromControl(control *oscalTypes.Control, removal oscalTypes.Removal) {
+	control.Params = filterOut(control.Params, func(param oscalTypes.Parameter) bool {
+		return matchesRemoval(removal, itemParam, param.ID, "", param.Class, "")
+	})
+	control.Props = filterOut(control.Props, func(prop oscalTypes.Property) bool {
+		return matchesRemoval(removal, itemProp, "", prop.Name, prop.Class, prop.Ns)
+	})
+	control.Links = filterOut(control.Links, func(link oscalTypes.Link) bool {
+		return matchesRemoval(removal, itemLink, "", "", "", "")
+	})
+	control.Parts = removeParts(control.Parts, removal)
+}
+
+func removeParts(parts *[]oscalTypes.Part, removal oscalTypes.Removal) *[]oscalTypes.Part {
+	parts = filterOut(parts, func(part oscalTypes.Part) bool {
+		return matchesRemoval(removal, itemPart, part.ID, part.Name, part.Class, part.Ns)
+	})
+	if parts == nil {
+		return nil
+	}
+	for i := range *parts {
+		part := &(*parts)[i]
+		part.Parts = removeParts(part.Parts, removal)
+		part.Props = filterOut(part.Props, func(prop oscalTypes.Property) bool {
+			return matchesRemoval(removal, itemProp, "", prop.Name, prop.Class, prop.Ns)
+		})
+		part.Links = filterOut(part.Links, func(link oscalTypes.Link) bool {
+			return matchesRemoval(removal, itemLink, "", "", "", "")
+		})
+	}
+	return parts
+}
+
+// matchesRemoval returns whether an item matches all criteria of a removal. A removal
+// without any criteria matches nothing.
+func matchesRemoval(removal oscalTypes.Removal, itemName, id, name, class, ns string) bool {
+	if removal == (oscalTypes.Removal{}) {
+		return false
+	}
+	if removal.ByItemName != "" && removal.ByItemName != itemName {
+		return false
+	}
+	if removal.ById != "" && removal.ById != id {
+		return false
+	}
+	if removal.ByName != "" && removal.ByName != name {
+		return false
+	}
+	if removal.ByClass != "" && removal.ByClass != class {
+		return false
+	}
+	if removal.ByNs != "" && removal.ByNs != ns {
+		return false
+	}
+	return true
+}
+
+// addToControl applies an addition to the control or to the part or parameter
+// identified by the addition by-id.
+func addToControl(control *oscalTypes.Control, addition oscalTypes.Addition) error {
+	position := addition.Position
+	if position == "" {
+		position = positionEnding
+	}
+
+	if addition.ById == "" || addition.ById == control.ID {
+		if addition.Title != "" {
+			control.Title = addition.Title
+		}
+		starting := position == positionStarting || position == positionBefore
+		control.Params = insertAll(control.Params, addition.Params, starting)
+		control.Props = insertAll(control.Props, addition.Props, starting)
+		control.Links = insertAll(control.Links, addition.Links, starting)
+		control.Parts = insertAll(control.Parts, addition.Parts, starting)
+		return nil
+	}
+
+	if control.Params != nil {
+		for i, param := range *control.Params {
+			if param.ID != addition.ById {
+				continue
+			}
+			if addition.Params != nil && (position == positionBefore || position == positionAfter) {
+				control.Params = insertSiblings(*control.Params, i, *addition.Params, position == positionBefore)
+				return nil
+			}
+			updated := make([]oscalTypes.Parameter, len(*control.Params))
+			copy(updated, *control.Params)
+			starting := position == positionStarting
+			updated[i].Props = insertAll(updated[i].Props, addition.Props, starting)
+			updated[i].Links = insertAll(updated[i].Links, addition.Links, starting)
+			control.Params = &updated
+			return nil
+		}
+	}
+
+	parts, found := addToParts(control.Parts, addition, position)
+	if !found {
+		return fmt.Errorf("addition target %q not found", addition.ById)
+	}
+	control.Parts = parts
+	return nil
+}
+
+// addToParts returns a copy of the parts with the addition applied to the part (at any depth)
+// identified by the addition by-id and whether the part was found.
+func addToParts(parts *[]oscalTypes.Part, addition oscalTypes.Addition, position string) (*[]oscalTypes.Part, bool) {
+	if parts == nil {
+		return nil, false
+	}
+	updated := make([]oscalTypes.Part, len(*parts))
+	copy(updated, *parts)
+	for i := range updated {
+		if updated[i].ID == addition.ById {
+			switch position {
+			case positionBefore, positionAfter:
+				if addition.Parts == nil {
+					return parts, true
+				}
+				return insertSiblings(updated, i, *addition.Parts, position == positionBefore), true
+			default:
+				starting := position == positionStarting
+				if addition.Title != "" {
+					updated[i].Title = addition.Title
+				}
+				updated[i].Props = insertAll(updated[i].Props, addition.Props, starting)
+				updated[i].Links = insertAll(updated[i].Links, addition.Links, starting)
+				updated[i].Parts = insertAll(updated[i].Parts, addition.Parts, starting)
+				return &updated, true
+			}
+		}
+		if subparts, found := addToParts(updated[i].Parts, addition, position); found {
+			updated[i].Parts = subparts
+			return &updated, true
+		}
+	}
+	return parts, false
+}
+
+// insertAll returns a new slice with the additions at the start or end of the existing items.
+func insertAll[T any](existing *[]T, additions *[]T, starting bool) *[]T {
+	if additions == nil || len(*additions) == 0 {
+		return existing
+	}
+	var items []T
+	if existing != nil {
+		items = *existing
+	}
+	var updated []T
+	if starting {
+		updated = append(append(updated, *additions...), items...)
+	} else {
+		updated = append(append(updated, items...), *additions...)
+	}
+	return &updated
+}
+
+// insertSiblings returns a new slice with the additions inserted before or after the item at idx.
+func insertSiblings[T any](items []T, idx int, additions []T, before bool) *[]T {
+	if !before {
+		idx++
+	}
+	updated := make([]T, 0, len(items)+len(additions))
+	updated = append(updated, items[:idx]...)
+	updated = append(updated, additions...)
+	updated = append(updated, items[idx:]...)
+	return &updated
+}
+
+// appendAll returns a new slice with the additions appended to the existing items.
+func appendAll[T any](existing *[]T, additions []T) *[]T {
+	return insertAll(existing, &additions, false)
+}
+
+// filterOut returns a new slice without the items matching the predicate.
+func filterOut[T any](items *[]T, remove func(T) bool) *[]T {
+	if items == nil {
+		return nil
+	}
+	var kept []T
+	for _, item := range *items {
+		if !remove(item) {
+			kept = append(kept, item)
+		}
+	}
+	return modelutils.NilIfEmpty(&kept)
+}
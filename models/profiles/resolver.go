@@ -0,0 +1,157 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+var (
+	// ErrCircularImport defines an error returned when a profile directly or
+	// indirectly imports itself.
+	ErrCircularImport = errors.New("circular profile import")
+	// ErrUnsupportedImport defines an error returned when an import does not
+	// reference a catalog or a profile.
+	ErrUnsupportedImport = errors.New("import must reference a catalog or profile")
+	// ErrUnsupportedHref defines an error returned when an href cannot be
+	// handled by a Loader.
+	ErrUnsupportedHref = errors.New("unsupported href")
+	// ErrResourceNotFound defines an error returned when a back-matter resource
+	// referenced by an import cannot be found.
+	ErrResourceNotFound = errors.New("back-matter resource not found")
+	// ErrControlNotFound defines an error returned when a control targeted
+	// by a profile alteration is not in the resolved catalog.
+	ErrControlNotFound = errors.New("control not found in resolved catalog")
+)
+
+// Resolver resolves OSCAL Profiles into OSCAL Catalogs following the profile
+// resolution steps of import, merge, and modify.
+type Resolver struct {
+	loader Loader
+}
+
+// NewResolver returns a new Resolver that retrieves imported models with
+// the given Loader.
+func NewResolver(loader Loader) *Resolver {
+	return &Resolver{
+		loader: loader,
+	}
+}
+
+// Resolve returns the resolved profile catalog for the given Profile.
+//
+// Imports referencing a back-matter resource (e.g. "#uuid") are resolved with the
+// embedded base64 content or the first resource link. Embedded content is decoded by the
+// Loader if it implements Decoder, and is not validated otherwise. Imported profiles are
+// resolved recursively before controls are selected from them.
+func (r *Resolver) Resolve(ctx context.Context, profile oscalTypes.Profile) (*oscalTypes.Catalog, error) {
+	return r.resolve(ctx, profile, set.New[string]())
+}
+
+func (r *Resolver) resolve(ctx context.Context, profile oscalTypes.Profile, visiting set.Set[string]) (*oscalTypes.Catalog, error) {
+	if visiting.Has(profile.UUID) {
+		return nil, fmt.Errorf("profile %q: %w", profile.UUID, ErrCircularImport)
+	}
+	visiting.Add(profile.UUID)
+	defer delete(visiting, profile.UUID)
+
+	var selections []oscalTypes.Catalog
+	for _, imp := range profile.Imports {
+		importedModels, err := r.load(ctx, profile, imp.Href)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load import %q: %w", imp.Href, err)
+		}
+
+		var catalog *oscalTypes.Catalog
+		switch {
+		case importedModels.Catalog != nil:
+			catalog = importedModels.Catalog
+		case importedModels.Profile != nil:
+			catalog, err = r.resolve(ctx, *importedModels.Profile, visiting)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve import %q: %w", imp.Href, err)
+			}
+		default:
+			return nil, fmt.Errorf("import %q: %w", imp.Href, ErrUnsupportedImport)
+		}
+		selections = append(selections, selectControls(*catalog, imp))
+	}
+
+	resolved := mergeCatalogs(profile.Merge, selections)
+	if err := modifyCatalog(&resolved, profile.Modify); err != nil {
+		return nil, fmt.Errorf("failed to modify profile %q: %w", profile.UUID, err)
+	}
+
+	metadata := profile.Metadata
+	metadata.LastModified = time.Now()
+	resolved.Metadata = metadata
+	resolved.UUID = uuid.NewUUID()
+
+	return &resolved, nil
+}
+
+// load returns the OSCAL models referenced by an import href. Fragment hrefs are
+// resolved through the profile back-matter.
+func (r *Resolver) load(ctx context.Context, profile oscalTypes.Profile, href string) (oscalTypes.OscalModels, error) {
+	if !strings.HasPrefix(href, "#") {
+		return r.loader.Load(ctx, href)
+	}
+
+	resource, err := findResource(profile.BackMatter, strings.TrimPrefix(href, "#"))
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+
+	if resource.Base64 != nil {
+		content, err := base64.StdEncoding.DecodeString(resource.Base64.Value)
+		if err != nil {
+			return oscalTypes.OscalModels{}, fmt.Errorf("failed to decode resource %q: %w", resource.UUID, err)
+		}
+		oscalModels, err := r.decode(ctx, content)
+		if err != nil {
+			return oscalTypes.OscalModels{}, fmt.Errorf("failed to decode resource %q: %w", resource.UUID, err)
+		}
+		return oscalModels, nil
+	}
+
+	if resource.Rlinks == nil || len(*resource.Rlinks) == 0 {
+		return oscalTypes.OscalModels{}, fmt.Errorf("resource %q has no content or links: %w", resource.UUID, ErrUnsupportedHref)
+	}
+	return r.loader.Load(ctx, (*resource.Rlinks)[0].Href)
+}
+
+// decode returns the OSCAL models for embedded content with the Decoder of the loader.
+func (r *Resolver) decode(ctx context.Context, content []byte) (oscalTypes.OscalModels, error) {
+	if decoder, ok := r.loader.(Decoder); ok {
+		return decoder.Decode(ctx, content)
+	}
+	return models.Decode(bytes.NewReader(content), models.FormatUnknown, validation.NoopValidator{})
+}
+
+// findResource returns the back-matter resource with the given UUID.
+func findResource(backMatter *oscalTypes.BackMatter, resourceUUID string) (oscalTypes.Resource, error) {
+	if backMatter != nil && backMatter.Resources != nil {
+		for _, resource := range *backMatter.Resources {
+			if resource.UUID == resourceUUID {
+				return resource, nil
+			}
+		}
+	}
+	return oscalTypes.Resource{}, fmt.Errorf("resource %q: %w", resourceUUID, ErrResourceNotFound)
+}
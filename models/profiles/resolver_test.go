@@ -0,0 +1,280 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+// mapLoader is a test Loader that returns models by href.
+type mapLoader map[string]oscalTypes.OscalModels
+
+func (m mapLoader) Load(_ context.Context, href string) (oscalTypes.OscalModels, error) {
+	oscalModels, ok := m[href]
+	if !ok {
+		return oscalTypes.OscalModels{}, fmt.Errorf("href %q not found", href)
+	}
+	return oscalModels, nil
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	catalog := readCatalog(t)
+	loader := mapLoader{
+		"catalog.json": {Catalog: catalog},
+	}
+
+	tests := []struct {
+		name       string
+		profile    oscalTypes.Profile
+		assertFunc func(*testing.T, *oscalTypes.Catalog)
+		expError   string
+	}{
+		{
+			name: "Success/IncludeAllFlat",
+			profile: oscalTypes.Profile{
+				UUID:    "profile",
+				Imports: []oscalTypes.Import{{Href: "catalog.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+			},
+			assertFunc: func(t *testing.T, resolved *oscalTypes.Catalog) {
+				require.Nil(t, resolved.Groups)
+				require.Equal(t, []string{"ac-1", "ac-2", "au-1"}, controlIDs(*resolved.Controls))
+				require.Len(t, *(*resolved.Controls)[1].Controls, 1)
+				require.Len(t, *resolved.BackMatter.Resources, 1)
+			},
+		},
+		{
+			name: "Success/ExcludeParentLiftsChildren",
+			profile: oscalTypes.Profile{
+				UUID: "profile",
+				Imports: []oscalTypes.Import{
+					{
+						Href:            "catalog.json",
+						IncludeAll:      &oscalTypes.IncludeAll{},
+						ExcludeControls: &[]oscalTypes.SelectControlById{{WithIds: &[]string{"ac-2"}}},
+					},
+				},
+				Merge: &oscalTypes.Merge{AsIs: true},
+			},
+			assertFunc: func(t *testing.T, resolved *oscalTypes.Catalog) {
+				require.Len(t, *resolved.Groups, 2)
+				acGroup := (*resolved.Groups)[0]
+				require.Equal(t, []string{"ac-1", "ac-2.1"}, controlIDs(*acGroup.Controls))
+			},
+		},
+		{
+			name: "Success/MatchingPattern",
+			profile: oscalTypes.Profile{
+				UUID: "profile",
+				Imports: []oscalTypes.Import{
+					{
+						Href:            "catalog.json",
+						IncludeControls: &[]oscalTypes.SelectControlById{{Matching: &[]oscalTypes.Matching{{Pattern: "au-*"}}}},
+					},
+				},
+				Merge: &oscalTypes.Merge{AsIs: true},
+			},
+			assertFunc: func(t *testing.T, resolved *oscalTypes.Catalog) {
+				require.Len(t, *resolved.Groups, 1)
+				require.Equal(t, "au", (*resolved.Groups)[0].ID)
+			},
+		},
+		{
+			name: "Success/CustomGrouping",
+			profile: oscalTypes.Profile{
+				UUID:    "profile",
+				Imports: []oscalTypes.Import{{Href: "catalog.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+				Merge: &oscalTypes.Merge{
+					Custom: &oscalTypes.CustomGrouping{
+						Groups: &[]oscalTypes.CustomGroupingGroup{
+							{
+								ID:    "policies",
+								Title: "Policies",
+								InsertControls: &[]oscalTypes.InsertControls{
+									{
+										IncludeControls: &[]oscalTypes.SelectControlById{{WithIds: &[]string{"ac-1", "au-1"}}},
+										Order:           "descending",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertFunc: func(t *testing.T, resolved *oscalTypes.Catalog) {
+				require.Nil(t, resolved.Controls)
+				require.Len(t, *resolved.Groups, 1)
+				require.Equal(t, []string{"au-1", "ac-1"}, controlIDs(*(*resolved.Groups)[0].Controls))
+			},
+		},
+		{
+			name: "Success/NestedProfile",
+			profile: oscalTypes.Profile{
+				UUID: "profile",
+				Imports: []oscalTypes.Import{
+					{
+						Href:            "profile.json",
+						IncludeControls: &[]oscalTypes.SelectControlById{{WithIds: &[]string{"ac-1"}}},
+					},
+				},
+			},
+			assertFunc: func(t *testing.T, resolved *oscalTypes.Catalog) {
+				require.Equal(t, []string{"ac-1"}, controlIDs(*resolved.Controls))
+			},
+		},
+		{
+			name: "Failure/CircularImport",
+			profile: oscalTypes.Profile{
+				UUID:    "circular",
+				Imports: []oscalTypes.Import{{Href: "circular.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+			},
+			expError: "circular profile import",
+		},
+		{
+			name: "Failure/AlterMissingControl",
+			profile: oscalTypes.Profile{
+				UUID:    "profile",
+				Imports: []oscalTypes.Import{{Href: "catalog.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+				Modify: &oscalTypes.Modify{
+					Alters: &[]oscalTypes.Alteration{{ControlId: "not-a-control"}},
+				},
+			},
+			expError: "failed to modify profile \"profile\": alteration for control \"not-a-control\": control not found in resolved catalog",
+		},
+		{
+			name: "Failure/MissingResource",
+			profile: oscalTypes.Profile{
+				UUID:    "profile",
+				Imports: []oscalTypes.Import{{Href: "#not-a-resource", IncludeAll: &oscalTypes.IncludeAll{}}},
+			},
+			expError: "failed to load import \"#not-a-resource\": resource \"not-a-resource\": back-matter resource not found",
+		},
+	}
+
+	loader["profile.json"] = oscalTypes.OscalModels{
+		Profile: &oscalTypes.Profile{
+			UUID:    "nested",
+			Imports: []oscalTypes.Import{{Href: "catalog.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+		},
+	}
+	loader["circular.json"] = oscalTypes.OscalModels{
+		Profile: &oscalTypes.Profile{
+			UUID:    "circular",
+			Imports: []oscalTypes.Import{{Href: "circular.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			resolver := NewResolver(loader)
+			resolved, err := resolver.Resolve(context.Background(), c.profile)
+			if c.expError != "" {
+				require.ErrorContains(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			c.assertFunc(t, resolved)
+		})
+	}
+}
+
+func TestResolver_ResolveFromFile(t *testing.T) {
+	file, err := os.Open("../../testdata/test-profile.json")
+	require.NoError(t, err)
+	profile, err := models.NewProfile(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	resolver := NewResolver(NewFileLoader("../../testdata", validation.NewSchemaValidator()))
+	resolved, err := resolver.Resolve(context.Background(), *profile)
+	require.NoError(t, err)
+
+	require.Equal(t, "Example Profile", resolved.Metadata.Title)
+	require.NotEqual(t, profile.UUID, resolved.UUID)
+	require.Len(t, *resolved.Groups, 1)
+	acGroup := (*resolved.Groups)[0]
+	require.Equal(t, []string{"ac-1", "ac-2"}, controlIDs(*acGroup.Controls))
+
+	ac1 := (*acGroup.Controls)[0]
+	require.Equal(t, []string{"annually"}, *(*ac1.Params)[0].Values)
+	require.Len(t, *ac1.Parts, 1)
+	require.Equal(t, "ac-1_smt", (*ac1.Parts)[0].ID)
+	require.Len(t, *ac1.Props, 3)
+	require.Equal(t, "status", (*ac1.Props)[2].Name)
+
+	ac2 := (*acGroup.Controls)[1]
+	require.Equal(t, []string{"ac-2.1"}, controlIDs(*ac2.Controls))
+
+	// Ensure the imported catalog is not altered during modification
+	catalog := readCatalog(t)
+	original := (*(*catalog.Groups)[0].Controls)[0]
+	_, err = NewResolver(mapLoader{"catalog.json": {Catalog: catalog}}).Resolve(context.Background(), oscalTypes.Profile{
+		UUID:    "profile",
+		Imports: []oscalTypes.Import{{Href: "catalog.json", IncludeAll: &oscalTypes.IncludeAll{}}},
+		Modify:  profile.Modify,
+	})
+	require.NoError(t, err)
+	require.Len(t, *original.Parts, 2)
+	require.Nil(t, (*original.Params)[0].Values)
+}
+
+func TestResolver_ResolveBase64Resource(t *testing.T) {
+	content, err := os.ReadFile("../../testdata/test-catalog.json")
+	require.NoError(t, err)
+
+	profile := oscalTypes.Profile{
+		UUID:    "profile",
+		Imports: []oscalTypes.Import{{Href: "#resource", IncludeAll: &oscalTypes.IncludeAll{}}},
+		BackMatter: &oscalTypes.BackMatter{
+			Resources: &[]oscalTypes.Resource{
+				{
+					UUID:   "resource",
+					Base64: &oscalTypes.Base64{Value: base64.StdEncoding.EncodeToString(content)},
+				},
+			},
+		},
+	}
+	resolved, err := NewResolver(mapLoader{}).Resolve(context.Background(), profile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ac-1", "ac-2", "au-1"}, controlIDs(*resolved.Controls))
+
+	// Embedded content is validated with the validator of the loader
+	catalog := readCatalog(t)
+	catalog.UUID = "not-a-uuid"
+	invalid, err := json.Marshal(oscalTypes.OscalModels{Catalog: catalog})
+	require.NoError(t, err)
+	(*profile.BackMatter.Resources)[0].Base64.Value = base64.StdEncoding.EncodeToString(invalid)
+
+	_, err = NewResolver(NewFileLoader("../../testdata", validation.NoopValidator{})).Resolve(context.Background(), profile)
+	require.NoError(t, err)
+	_, err = NewResolver(NewFileLoader("../../testdata", validation.NewSchemaValidator())).Resolve(context.Background(), profile)
+	require.ErrorContains(t, err, "failed to decode resource \"resource\"")
+}
+
+func readCatalog(t *testing.T) *oscalTypes.Catalog {
+	file, err := os.Open("../../testdata/test-catalog.json")
+	require.NoError(t, err)
+	catalog, err := models.NewCatalog(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	return catalog
+}
+
+func controlIDs(controls []oscalTypes.Control) []string {
+	var ids []string
+	for _, control := range controls {
+		ids = append(ids, control.ID)
+	}
+	return ids
+}
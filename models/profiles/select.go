@@ -0,0 +1,172 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package profiles
+
+import (
+	"path"
+	"slices"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+const withChildControls = "yes"
+
+// selectControls returns a Catalog containing only the controls selected by the given
+// import. Groups without any selected controls are removed. Selected child controls of
+// unselected parents are moved up to the level of the parent.
+func selectControls(catalog oscalTypes.Catalog, imp oscalTypes.Import) oscalTypes.Catalog {
+	var include, exclude []oscalTypes.SelectControlById
+	if imp.IncludeControls != nil {
+		include = *imp.IncludeControls
+	}
+	if imp.ExcludeControls != nil {
+		exclude = *imp.ExcludeControls
+	}
+	selected := selectedIDs(allControls(catalog), imp.IncludeAll != nil, include, exclude)
+
+	selection := oscalTypes.Catalog{
+		Params:     catalog.Params,
+		BackMatter: catalog.BackMatter,
+	}
+	if catalog.Groups != nil {
+		groups := filterGroups(*catalog.Groups, selected)
+		selection.Groups = modelutils.NilIfEmpty(&groups)
+	}
+	if catalog.Controls != nil {
+		controls := filterControls(*catalog.Controls, selected)
+		selection.Controls = modelutils.NilIfEmpty(&controls)
+	}
+	return selection
+}
+
+// allControls returns the top-level controls of a catalog and all controls nested in groups.
+func allControls(catalog oscalTypes.Catalog) []oscalTypes.Control {
+	var controls []oscalTypes.Control
+	if catalog.Controls != nil {
+		controls = append(controls, *catalog.Controls...)
+	}
+	if catalog.Groups != nil {
+		controls = append(controls, controlsInGroups(*catalog.Groups)...)
+	}
+	return controls
+}
+
+func controlsInGroups(groups []oscalTypes.Group) []oscalTypes.Control {
+	var controls []oscalTypes.Control
+	for _, group := range groups {
+		if group.Controls != nil {
+			controls = append(controls, *group.Controls...)
+		}
+		if group.Groups != nil {
+			controls = append(controls, controlsInGroups(*group.Groups)...)
+		}
+	}
+	return controls
+}
+
+// selectedIDs returns the IDs of all controls (at any depth) that are included
+// and not excluded by the given selections.
+func selectedIDs(controls []oscalTypes.Control, includeAll bool, include, exclude []oscalTypes.SelectControlById) set.Set[string] {
+	included := set.New[string]()
+	excluded := set.New[string]()
+	var walk func(controls []oscalTypes.Control, includedParent, excludedParent bool)
+	walk = func(controls []oscalTypes.Control, includedParent, excludedParent bool) {
+		for _, control := range controls {
+			includeChildren, excludeChildren := includedParent, excludedParent
+			if includeAll || includedParent {
+				included.Add(control.ID)
+			}
+			for _, selection := range include {
+				if matchesSelection(control.ID, selection) {
+					included.Add(control.ID)
+					if selection.WithChildControls == withChildControls {
+						includeChildren = true
+					}
+				}
+			}
+			if excludedParent {
+				excluded.Add(control.ID)
+			}
+			for _, selection := range exclude {
+				if matchesSelection(control.ID, selection) {
+					excluded.Add(control.ID)
+					if selection.WithChildControls == withChildControls {
+						excludeChildren = true
+					}
+				}
+			}
+			if control.Controls != nil {
+				walk(*control.Controls, includeChildren, excludeChildren)
+			}
+		}
+	}
+	walk(controls, false, false)
+
+	for id := range excluded {
+		delete(included, id)
+	}
+	return included
+}
+
+// matchesSelection returns whether the control ID is listed in or matches a pattern
+// of the given selection.
+func matchesSelection(controlID string, selection oscalTypes.SelectControlById) bool {
+	if selection.WithIds != nil && slices.Contains(*selection.WithIds, controlID) {
+		return true
+	}
+	if selection.Matching != nil {
+		for _, matching := range *selection.Matching {
+			if matched, err := path.Match(matching.Pattern, controlID); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterControls returns copies of the selected controls. The children of unselected
+// controls that are selected are returned in place of their parent.
+func filterControls(controls []oscalTypes.Control, selected set.Set[string]) []oscalTypes.Control {
+	var filtered []oscalTypes.Control
+	for _, control := range controls {
+		var children []oscalTypes.Control
+		if control.Controls != nil {
+			children = filterControls(*control.Controls, selected)
+		}
+		if !selected.Has(control.ID) {
+			filtered = append(filtered, children...)
+			continue
+		}
+		control.Controls = modelutils.NilIfEmpty(&children)
+		filtered = append(filtered, control)
+	}
+	return filtered
+}
+
+// filterGroups returns copies of the groups that contain at least one selected control.
+func filterGroups(groups []oscalTypes.Group, selected set.Set[string]) []oscalTypes.Group {
+	var filtered []oscalTypes.Group
+	for _, group := range groups {
+		var controls []oscalTypes.Control
+		var subgroups []oscalTypes.Group
+		if group.Controls != nil {
+			controls = filterControls(*group.Controls, selected)
+		}
+		if group.Groups != nil {
+			subgroups = filterGroups(*group.Groups, selected)
+		}
+		if len(controls) == 0 && len(subgroups) == 0 {
+			continue
+		}
+		group.Controls = modelutils.NilIfEmpty(&controls)
+		group.Groups = modelutils.NilIfEmpty(&subgroups)
+		filtered = append(filtered, group)
+	}
+	return filtered
+}
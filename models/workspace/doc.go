@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package workspace defines logic for working with OSCAL models stored in a
+// compliance-trestle workspace, where each model is stored at $MODEL_DIR/$NAME/$MODEL.json.
+package workspace
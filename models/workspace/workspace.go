@@ -0,0 +1,303 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/profiles"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+var _ profiles.Loader = (*Workspace)(nil)
+
+// TrestleScheme is the URI scheme for hrefs relative to the workspace root.
+const TrestleScheme = "trestle://"
+
+var (
+	// ErrModelNotFound defines an error returned when a model is not in the workspace.
+	ErrModelNotFound = errors.New("model not found in workspace")
+	// ErrOutsideWorkspace defines an error returned when a `trestle://` href
+	// points outside the workspace root.
+	ErrOutsideWorkspace = errors.New("href points outside the workspace")
+)
+
+// modelDirs are the workspace directories for each model type. Trestle stores
+// POA&Ms in "plan-of-action-and-milestones"; "plans-of-action-and-milestones" is
+// accepted as well.
+var modelDirs = []struct {
+	modelType models.ModelType
+	dirs      []string
+}{
+	{models.ModelTypeCatalog, []string{"catalogs"}},
+	{models.ModelTypeProfile, []string{"profiles"}},
+	{models.ModelTypeComponentDefinition, []string{"component-definitions"}},
+	{models.ModelTypeSystemSecurityPlan, []string{"system-security-plans"}},
+	{models.ModelTypeAssessmentPlan, []string{"assessment-plans"}},
+	{models.ModelTypeAssessmentResults, []string{"assessment-results"}},
+	{models.ModelTypePOAM, []string{"plan-of-action-and-milestones", "plans-of-action-and-milestones"}},
+}
+
+// modelExtensions are the supported model file extensions in order of preference.
+var modelExtensions = []string{".json", ".yaml", ".yml", ".xml"}
+
+type workspaceOpts struct {
+	validator validation.Validator
+}
+
+func (w *workspaceOpts) defaults() {
+	w.validator = validation.NoopValidator{}
+}
+
+// Option defines an option to tune the behavior of a Workspace.
+type Option func(opts *workspaceOpts)
+
+// WithValidator is an Option that validates models with the given validator
+// when they are loaded.
+func WithValidator(validator validation.Validator) Option {
+	return func(opts *workspaceOpts) {
+		opts.validator = validator
+	}
+}
+
+// Workspace is an index of the OSCAL models in a trestle workspace. Models are
+// loaded lazily on first access and cached, so callers must not modify returned models.
+// A Workspace is safe for concurrent use.
+type Workspace struct {
+	root      string
+	validator validation.Validator
+	// paths holds the model file path by model type and name.
+	paths map[models.ModelType]map[string]string
+
+	mu        sync.Mutex
+	documents map[string]models.Document
+}
+
+// Open indexes the trestle workspace at the given root directory. Model directories
+// that do not exist are skipped. Only the directory layout is read; models are
+// loaded when first accessed.
+func Open(root string, opts ...Option) (*Workspace, error) {
+	options := workspaceOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workspace root %q: %w", root, err)
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open workspace: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("failed to open workspace: %q is not a directory", root)
+	}
+
+	w := &Workspace{
+		root:      root,
+		validator: options.validator,
+		paths:     make(map[models.ModelType]map[string]string),
+		documents: make(map[string]models.Document),
+	}
+	for _, modelDir := range modelDirs {
+		names := make(map[string]string)
+		for _, dir := range modelDir.dirs {
+			if err := indexModels(filepath.Join(root, dir), modelDir.modelType, names); err != nil {
+				return nil, err
+			}
+		}
+		w.paths[modelDir.modelType] = names
+	}
+	return w, nil
+}
+
+// indexModels adds the model files in a model directory to names.
+func indexModels(dir string, modelType models.ModelType, names map[string]string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to index %q: %w", dir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, found := names[entry.Name()]; found {
+			continue
+		}
+		for _, ext := range modelExtensions {
+			path := filepath.Join(dir, entry.Name(), string(modelType)+ext)
+			if _, err := os.Stat(path); err == nil {
+				names[entry.Name()] = path
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// Root returns the absolute path of the workspace root directory.
+func (w *Workspace) Root() string {
+	return w.root
+}
+
+// Names returns the sorted names of the models of the given type in the workspace.
+func (w *Workspace) Names(modelType models.ModelType) []string {
+	names := make([]string, 0, len(w.paths[modelType]))
+	for name := range w.paths[modelType] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Path returns the file path of the named model of the given type.
+func (w *Workspace) Path(modelType models.ModelType, name string) (string, error) {
+	path, found := w.paths[modelType][name]
+	if !found {
+		return "", fmt.Errorf("%s %q: %w", modelType, name, ErrModelNotFound)
+	}
+	return path, nil
+}
+
+// Document returns the named model of the given type.
+func (w *Workspace) Document(modelType models.ModelType, name string) (models.Document, error) {
+	path, err := w.Path(modelType, name)
+	if err != nil {
+		return models.Document{}, err
+	}
+	document, err := w.load(path)
+	if err != nil {
+		return models.Document{}, fmt.Errorf("failed to load %s %q: %w", modelType, name, err)
+	}
+	if document.Type != modelType {
+		return models.Document{}, fmt.Errorf("%s %q: %w: found %s", modelType, name, models.ErrUnexpectedModel, document.Type)
+	}
+	return document, nil
+}
+
+// Catalog returns the named OSCAL Catalog.
+func (w *Workspace) Catalog(name string) (*oscalTypes.Catalog, error) {
+	return get[oscalTypes.Catalog](w, name)
+}
+
+// Profile returns the named OSCAL Profile.
+func (w *Workspace) Profile(name string) (*oscalTypes.Profile, error) {
+	return get[oscalTypes.Profile](w, name)
+}
+
+// ComponentDefinition returns the named OSCAL Component Definition.
+func (w *Workspace) ComponentDefinition(name string) (*oscalTypes.ComponentDefinition, error) {
+	return get[oscalTypes.ComponentDefinition](w, name)
+}
+
+// SystemSecurityPlan returns the named OSCAL System Security Plan.
+func (w *Workspace) SystemSecurityPlan(name string) (*oscalTypes.SystemSecurityPlan, error) {
+	return get[oscalTypes.SystemSecurityPlan](w, name)
+}
+
+// AssessmentPlan returns the named OSCAL Assessment Plan.
+func (w *Workspace) AssessmentPlan(name string) (*oscalTypes.AssessmentPlan, error) {
+	return get[oscalTypes.AssessmentPlan](w, name)
+}
+
+// AssessmentResults returns the named OSCAL Assessment Results.
+func (w *Workspace) AssessmentResults(name string) (*oscalTypes.AssessmentResults, error) {
+	return get[oscalTypes.AssessmentResults](w, name)
+}
+
+// POAM returns the named OSCAL Plan of Action and Milestones.
+func (w *Workspace) POAM(name string) (*oscalTypes.PlanOfActionAndMilestones, error) {
+	return get[oscalTypes.PlanOfActionAndMilestones](w, name)
+}
+
+func get[T models.Model](w *Workspace, name string) (*T, error) {
+	document, err := w.Document(models.ModelTypeOf[T](), name)
+	if err != nil {
+		return nil, err
+	}
+	return models.As[T](document)
+}
+
+// Load returns the OSCAL models for a `trestle://` URI, `file://` URL, absolute path,
+// or path relative to the workspace root, following trestle href conventions.
+// It allows a Workspace to be used as a profiles.Loader.
+func (w *Workspace) Load(_ context.Context, href string) (oscalTypes.OscalModels, error) {
+	path, err := w.ResolveHref(href)
+	if err != nil {
+		return oscalTypes.OscalModels{}, err
+	}
+	document, err := w.load(path)
+	if err != nil {
+		return oscalTypes.OscalModels{}, fmt.Errorf("failed to load %q: %w", href, err)
+	}
+	return document.Models, nil
+}
+
+// Decode returns the OSCAL models for embedded content in any supported format,
+// validated with the workspace validator.
+func (w *Workspace) Decode(_ context.Context, content []byte) (oscalTypes.OscalModels, error) {
+	return models.Decode(bytes.NewReader(content), models.FormatUnknown, w.validator)
+}
+
+// ResolveHref returns the file path referenced by a `trestle://` URI, `file://` URL,
+// absolute path, or path relative to the workspace root.
+func (w *Workspace) ResolveHref(href string) (string, error) {
+	switch {
+	case strings.HasPrefix(href, TrestleScheme):
+		relative := filepath.FromSlash(strings.TrimPrefix(href, TrestleScheme))
+		if !filepath.IsLocal(relative) {
+			return "", fmt.Errorf("href %q: %w", href, ErrOutsideWorkspace)
+		}
+		return filepath.Join(w.root, relative), nil
+	case strings.HasPrefix(href, "file://"):
+		parsed, err := url.Parse(href)
+		if err != nil {
+			return "", fmt.Errorf("invalid href %q: %w", href, err)
+		}
+		return filepath.FromSlash(parsed.Path), nil
+	case strings.Contains(href, "://"):
+		return "", fmt.Errorf("href %q: %w", href, profiles.ErrUnsupportedHref)
+	case filepath.IsAbs(href):
+		return href, nil
+	default:
+		return filepath.Join(w.root, filepath.FromSlash(href)), nil
+	}
+}
+
+// load returns the cached document at the given path or loads it.
+func (w *Workspace) load(path string) (models.Document, error) {
+	path = filepath.Clean(path)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if document, found := w.documents[path]; found {
+		return document, nil
+	}
+	document, err := models.LoadFile(path, w.validator)
+	if err != nil {
+		return models.Document{}, err
+	}
+	w.documents[path] = document
+	return document, nil
+}
@@ -0,0 +1,161 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/profiles"
+)
+
+// newTestWorkspace creates a trestle workspace from the shared test data.
+func newTestWorkspace(t *testing.T) string {
+	root := t.TempDir()
+	profile := strings.ReplaceAll(readTestData(t, "test-profile.json"), `"test-catalog.json"`, `"trestle://catalogs/nist/catalog.json"`)
+	files := map[string]string{
+		"catalogs/nist/catalog.json":                                             readTestData(t, "test-catalog.json"),
+		"catalogs/misplaced/catalog.json":                                        readTestData(t, "test-profile.json"),
+		"profiles/example/profile.json":                                          profile,
+		"component-definitions/test/component-definition.json":                   readTestData(t, "component-definition-test.json"),
+		"system-security-plans/example/system-security-plan.json":                readTestData(t, "test-ssp.json"),
+		"assessment-plans/example/assessment-plan.json":                          readTestData(t, "test-ap.json"),
+		"plan-of-action-and-milestones/example/not-a-model.json":                 "{}",
+		"plans-of-action-and-milestones/poam/plan-of-action-and-milestones.yaml": "plan-of-action-and-milestones:\n  uuid: poam-1\n",
+	}
+	for path, content := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	return root
+}
+
+func readTestData(t *testing.T, name string) string {
+	data, err := os.ReadFile(filepath.Join("../../testdata", name))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestOpen(t *testing.T) {
+	root := newTestWorkspace(t)
+	ws, err := Open(root)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"misplaced", "nist"}, ws.Names(models.ModelTypeCatalog))
+	require.Equal(t, []string{"example"}, ws.Names(models.ModelTypeProfile))
+	require.Equal(t, []string{"test"}, ws.Names(models.ModelTypeComponentDefinition))
+	require.Equal(t, []string{"example"}, ws.Names(models.ModelTypeSystemSecurityPlan))
+	require.Equal(t, []string{"example"}, ws.Names(models.ModelTypeAssessmentPlan))
+	require.Empty(t, ws.Names(models.ModelTypeAssessmentResults))
+	require.Equal(t, []string{"poam"}, ws.Names(models.ModelTypePOAM))
+
+	path, err := ws.Path(models.ModelTypeCatalog, "nist")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(ws.Root(), "catalogs", "nist", "catalog.json"), path)
+
+	_, err = Open(filepath.Join(root, "missing"))
+	require.Error(t, err)
+}
+
+func TestWorkspace_Models(t *testing.T) {
+	ws, err := Open(newTestWorkspace(t))
+	require.NoError(t, err)
+
+	catalog, err := ws.Catalog("nist")
+	require.NoError(t, err)
+	require.Equal(t, "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d", catalog.UUID)
+
+	// Models are cached after the first load.
+	cached, err := ws.Catalog("nist")
+	require.NoError(t, err)
+	require.Same(t, catalog, cached)
+
+	definition, err := ws.ComponentDefinition("test")
+	require.NoError(t, err)
+	require.NotNil(t, definition.Components)
+
+	poam, err := ws.POAM("poam")
+	require.NoError(t, err)
+	require.Equal(t, "poam-1", poam.UUID)
+
+	_, err = ws.Catalog("missing")
+	require.ErrorIs(t, err, ErrModelNotFound)
+
+	_, err = ws.Catalog("misplaced")
+	require.ErrorIs(t, err, models.ErrUnexpectedModel)
+}
+
+func TestWorkspace_ResolveHref(t *testing.T) {
+	ws, err := Open(t.TempDir())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		href     string
+		wantPath string
+		expError error
+	}{
+		{
+			name:     "Success/Trestle",
+			href:     "trestle://catalogs/nist/catalog.json",
+			wantPath: filepath.Join(ws.Root(), "catalogs", "nist", "catalog.json"),
+		},
+		{
+			name:     "Success/Relative",
+			href:     "profiles/example/profile.json",
+			wantPath: filepath.Join(ws.Root(), "profiles", "example", "profile.json"),
+		},
+		{
+			name:     "Success/FileURL",
+			href:     "file:///tmp/catalog.json",
+			wantPath: filepath.FromSlash("/tmp/catalog.json"),
+		},
+		{
+			name:     "Failure/TrestleOutsideWorkspace",
+			href:     "trestle://../catalog.json",
+			expError: ErrOutsideWorkspace,
+		},
+		{
+			name:     "Failure/UnsupportedScheme",
+			href:     "https://example.com/catalog.json",
+			expError: profiles.ErrUnsupportedHref,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			path, err := ws.ResolveHref(c.href)
+			if c.expError != nil {
+				require.ErrorIs(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.wantPath, path)
+		})
+	}
+}
+
+func TestWorkspace_ResolveProfile(t *testing.T) {
+	ws, err := Open(newTestWorkspace(t))
+	require.NoError(t, err)
+
+	profile, err := ws.Profile("example")
+	require.NoError(t, err)
+
+	resolved, err := profiles.NewResolver(ws).Resolve(context.TODO(), *profile)
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+
+	oscalModels, err := ws.Load(context.TODO(), "trestle://catalogs/nist/catalog.json")
+	require.NoError(t, err)
+	require.NotNil(t, oscalModels.Catalog)
+}
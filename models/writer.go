@@ -0,0 +1,259 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+// ErrNoPrevious defines an error returned when writing in stable mode without a previous
+// version of the document.
+var ErrNoPrevious = errors.New("stable mode requires a previous version of the document")
+
+type writeOptions struct {
+	format    Format
+	validator validation.Validator
+	stable    bool
+	previous  *oscalTypes.OscalModels
+}
+
+func (w *writeOptions) defaults() {
+	w.format = FormatJSON
+	w.validator = validation.NoopValidator{}
+}
+
+// WriteOption defines an option for writing OSCAL documents.
+type WriteOption func(opts *writeOptions)
+
+// WithOutputFormat defines a WriteOption to write the document in the given format.
+// The default is FormatJSON. WriteFile uses the format of the file extension by default.
+func WithOutputFormat(format Format) WriteOption {
+	return func(opts *writeOptions) {
+		opts.format = format
+	}
+}
+
+// WithValidator defines a WriteOption to validate the document before writing.
+func WithValidator(validator validation.Validator) WriteOption {
+	return func(opts *writeOptions) {
+		opts.validator = validator
+	}
+}
+
+// WithStableMode defines a WriteOption to keep the last-modified timestamp of the previous
+// version of the document when nothing else in the document changed. WriteFile reads
+// the previous version from the file being replaced, if it exists. Write requires the
+// previous version to be set with WithPrevious.
+func WithStableMode() WriteOption {
+	return func(opts *writeOptions) {
+		opts.stable = true
+	}
+}
+
+// WithPrevious defines a WriteOption that enables stable mode with the given previous
+// version of the document.
+func WithPrevious(previous oscalTypes.OscalModels) WriteOption {
+	return func(opts *writeOptions) {
+		opts.stable = true
+		opts.previous = &previous
+	}
+}
+
+// Write writes the OSCAL models in canonical form. In stable mode, the last-modified
+// timestamp of the previous version is kept if the content is otherwise unchanged. An
+// error wrapping ErrNoPrevious is returned in stable mode without a previous version.
+func Write(writer io.Writer, oscalModels oscalTypes.OscalModels, opts ...WriteOption) error {
+	var options writeOptions
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.stable && options.previous == nil {
+		return fmt.Errorf("failed to write document: %w", ErrNoPrevious)
+	}
+	return write(writer, oscalModels, options)
+}
+
+// write writes the OSCAL models with the given options.
+func write(writer io.Writer, oscalModels oscalTypes.OscalModels, options writeOptions) error {
+	if options.stable && options.previous != nil {
+		stable, err := keepLastModified(oscalModels, *options.previous)
+		if err != nil {
+			return err
+		}
+		oscalModels = stable
+	}
+	return Encode(writer, oscalModels, options.format, options.validator)
+}
+
+// WriteFile writes the OSCAL models to a file with Write. The format is detected from
+// the file extension unless set with WithOutputFormat.
+func WriteFile(path string, oscalModels oscalTypes.OscalModels, opts ...WriteOption) error {
+	var options writeOptions
+	options.defaults()
+	options.format = FormatFromPath(path)
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.format == FormatUnknown {
+		return fmt.Errorf("%w: cannot determine format of %q", ErrUnsupportedFormat, path)
+	}
+
+	if options.stable && options.previous == nil {
+		previous, err := DecodeFile(path, validation.NoopValidator{})
+		switch {
+		case err == nil:
+			options.previous = &previous
+		case !errors.Is(err, fs.ErrNotExist):
+			return fmt.Errorf("failed to read previous version of %q: %w", path, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := write(&buf, oscalModels, options); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Clean(path), buf.Bytes(), 0600)
+}
+
+// WriteCatalog writes an OSCAL catalog with Write.
+func WriteCatalog(writer io.Writer, catalog *oscalTypes.Catalog, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{Catalog: catalog}, opts...)
+}
+
+// WriteProfile writes an OSCAL profile with Write.
+func WriteProfile(writer io.Writer, profile *oscalTypes.Profile, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{Profile: profile}, opts...)
+}
+
+// WriteComponentDefinition writes an OSCAL component definition with Write.
+func WriteComponentDefinition(writer io.Writer, componentDefinition *oscalTypes.ComponentDefinition, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{ComponentDefinition: componentDefinition}, opts...)
+}
+
+// WriteSystemSecurityPlan writes an OSCAL system security plan with Write.
+func WriteSystemSecurityPlan(writer io.Writer, systemSecurityPlan *oscalTypes.SystemSecurityPlan, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{SystemSecurityPlan: systemSecurityPlan}, opts...)
+}
+
+// WriteAssessmentPlan writes an OSCAL assessment plan with Write.
+func WriteAssessmentPlan(writer io.Writer, assessmentPlan *oscalTypes.AssessmentPlan, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{AssessmentPlan: assessmentPlan}, opts...)
+}
+
+// WriteAssessmentResults writes an OSCAL assessment results set with Write.
+func WriteAssessmentResults(writer io.Writer, assessmentResults *oscalTypes.AssessmentResults, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{AssessmentResults: assessmentResults}, opts...)
+}
+
+// WritePOAM writes an OSCAL plan of action and milestones with Write.
+func WritePOAM(writer io.Writer, pOAM *oscalTypes.PlanOfActionAndMilestones, opts ...WriteOption) error {
+	return Write(writer, oscalTypes.OscalModels{PlanOfActionAndMilestones: pOAM}, opts...)
+}
+
+// keepLastModified returns the OSCAL models with the last-modified timestamp of the previous
+// version if both only differ in the timestamp. The input models are not modified.
+func keepLastModified(current, previous oscalTypes.OscalModels) (oscalTypes.OscalModels, error) {
+	currentMetadata := metadataOf(&current)
+	previousMetadata := metadataOf(&previous)
+	if currentMetadata == nil || previousMetadata == nil {
+		return current, nil
+	}
+
+	candidate, err := withLastModified(current, previousMetadata.LastModified)
+	if err != nil {
+		return current, err
+	}
+
+	var candidateJSON, previousJSON bytes.Buffer
+	if err := encodeCanonicalJSON(&candidateJSON, candidate); err != nil {
+		return current, err
+	}
+	if err := encodeCanonicalJSON(&previousJSON, previous); err != nil {
+		return current, err
+	}
+	if bytes.Equal(candidateJSON.Bytes(), previousJSON.Bytes()) {
+		return candidate, nil
+	}
+	return current, nil
+}
+
+// withLastModified returns a copy of the OSCAL models with a new last-modified timestamp.
+// Only the model holding the metadata is copied.
+func withLastModified(oscalModels oscalTypes.OscalModels, lastModified time.Time) (oscalTypes.OscalModels, error) {
+	modelType, err := DetectModelType(oscalModels)
+	if err != nil {
+		return oscalModels, err
+	}
+	switch modelType {
+	case ModelTypeCatalog:
+		model := *oscalModels.Catalog
+		model.Metadata.LastModified = lastModified
+		oscalModels.Catalog = &model
+	case ModelTypeProfile:
+		model := *oscalModels.Profile
+		model.Metadata.LastModified = lastModified
+		oscalModels.Profile = &model
+	case ModelTypeComponentDefinition:
+		model := *oscalModels.ComponentDefinition
+		model.Metadata.LastModified = lastModified
+		oscalModels.ComponentDefinition = &model
+	case ModelTypeSystemSecurityPlan:
+		model := *oscalModels.SystemSecurityPlan
+		model.Metadata.LastModified = lastModified
+		oscalModels.SystemSecurityPlan = &model
+	case ModelTypeAssessmentPlan:
+		model := *oscalModels.AssessmentPlan
+		model.Metadata.LastModified = lastModified
+		oscalModels.AssessmentPlan = &model
+	case ModelTypeAssessmentResults:
+		model := *oscalModels.AssessmentResults
+		model.Metadata.LastModified = lastModified
+		oscalModels.AssessmentResults = &model
+	case ModelTypePOAM:
+		model := *oscalModels.PlanOfActionAndMilestones
+		model.Metadata.LastModified = lastModified
+		oscalModels.PlanOfActionAndMilestones = &model
+	}
+	return oscalModels, nil
+}
+
+// metadataOf returns the metadata of the single model in the OSCAL models or nil.
+func metadataOf(oscalModels *oscalTypes.OscalModels) *oscalTypes.Metadata {
+	modelType, err := DetectModelType(*oscalModels)
+	if err != nil {
+		return nil
+	}
+	switch modelType {
+	case ModelTypeCatalog:
+		return &oscalModels.Catalog.Metadata
+	case ModelTypeProfile:
+		return &oscalModels.Profile.Metadata
+	case ModelTypeComponentDefinition:
+		return &oscalModels.ComponentDefinition.Metadata
+	case ModelTypeSystemSecurityPlan:
+		return &oscalModels.SystemSecurityPlan.Metadata
+	case ModelTypeAssessmentPlan:
+		return &oscalModels.AssessmentPlan.Metadata
+	case ModelTypeAssessmentResults:
+		return &oscalModels.AssessmentResults.Metadata
+	case ModelTypePOAM:
+		return &oscalModels.PlanOfActionAndMilestones.Metadata
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func testCatalog(lastModified time.Time) *oscalTypes.Catalog {
+	return &oscalTypes.Catalog{
+		UUID: "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d",
+		Metadata: oscalTypes.Metadata{
+			Title:        "Catalog",
+			LastModified: lastModified,
+			Version:      "1.0.0",
+			OscalVersion: "1.1.3",
+		},
+		Groups: &[]oscalTypes.Group{
+			{
+				ID:    "ac",
+				Class: "family",
+				Title: "Access Control",
+				Controls: &[]oscalTypes.Control{
+					{
+						ID:    "ac-1",
+						Class: "SP800-53",
+						Title: "Policy & Procedures",
+						Props: &[]oscalTypes.Property{{Name: "label", Value: "AC-1"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteCatalog(t *testing.T) {
+	lastModified := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCatalog(&buf, testCatalog(lastModified)))
+	require.Equal(t, `{
+  "catalog": {
+    "uuid": "6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d",
+    "metadata": {
+      "title": "Catalog",
+      "last-modified": "2025-01-01T00:00:00Z",
+      "version": "1.0.0",
+      "oscal-version": "1.1.3"
+    },
+    "groups": [
+      {
+        "id": "ac",
+        "class": "family",
+        "title": "Access Control",
+        "controls": [
+          {
+            "id": "ac-1",
+            "class": "SP800-53",
+            "title": "Policy & Procedures",
+            "props": [
+              {
+                "name": "label",
+                "value": "AC-1"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  }
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteCatalog(&buf, testCatalog(lastModified), WithOutputFormat(FormatYAML)))
+	require.Equal(t, `catalog:
+  uuid: 6a1e3b2c-6a34-4d58-a4f2-1b2f6f0c3b7d
+  metadata:
+    title: Catalog
+    last-modified: "2025-01-01T00:00:00Z"
+    version: 1.0.0
+    oscal-version: 1.1.3
+  groups:
+    - id: ac
+      class: family
+      title: Access Control
+      controls:
+        - id: ac-1
+          class: SP800-53
+          title: Policy & Procedures
+          props:
+            - name: label
+              value: AC-1
+`, buf.String())
+}
+
+func TestWrite_MetaschemaOrder(t *testing.T) {
+	ssp := &oscalTypes.SystemSecurityPlan{
+		UUID: "cff8385f-108e-40a5-8f7a-82f3dc0eaba8",
+		SystemCharacteristics: oscalTypes.SystemCharacteristics{
+			SystemIds:   []oscalTypes.SystemId{{ID: "system"}},
+			Description: "A system.",
+		},
+		ControlImplementation: oscalTypes.ControlImplementation{
+			Description: "Controls.",
+			ImplementedRequirements: []oscalTypes.ImplementedRequirement{
+				{
+					ControlId:     "ac-1",
+					SetParameters: &[]oscalTypes.SetParameter{{ParamId: "ac-1_prm_1"}},
+					ByComponents: &[]oscalTypes.ByComponent{
+						{
+							ComponentUuid:        "component",
+							ImplementationStatus: &oscalTypes.ImplementationStatus{State: "implemented"},
+							SetParameters:        &[]oscalTypes.SetParameter{{ParamId: "ac-1_prm_2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		var buf bytes.Buffer
+		require.NoError(t, Write(&buf, oscalTypes.OscalModels{SystemSecurityPlan: ssp}, WithOutputFormat(format)))
+		output := buf.String()
+		requireOrder := func(before, after string) {
+			require.Less(t, strings.Index(output, before), strings.Index(output, after), "%s before %s", before, after)
+		}
+		requireOrder("system-characteristics", "control-implementation")
+		requireOrder("system-ids", "description")
+		requireOrder("ac-1_prm_1", "by-components")
+		requireOrder("ac-1_prm_2", "implementation-status")
+	}
+}
+
+func TestWrite_Deterministic(t *testing.T) {
+	for _, testDataPath := range []string{
+		"../testdata/test-ssp.json",
+		"../testdata/test-ap.json",
+		"../testdata/test-profile.json",
+		"../testdata/component-definition-test.json",
+	} {
+		t.Run(testDataPath, func(t *testing.T) {
+			want, err := DecodeFile(testDataPath, validation.NoopValidator{})
+			require.NoError(t, err)
+
+			for _, format := range []Format{FormatJSON, FormatYAML, FormatXML} {
+				var first, second bytes.Buffer
+				require.NoError(t, Write(&first, want, WithOutputFormat(format)))
+				require.NoError(t, Write(&second, want, WithOutputFormat(format)))
+				require.Equal(t, first.String(), second.String())
+
+				got, err := Decode(&first, format, validation.NoopValidator{})
+				require.NoError(t, err)
+				requireModelsEqual(t, want, got)
+			}
+		})
+	}
+}
+
+func TestWrite_Validator(t *testing.T) {
+	wantErr := errors.New("invalid")
+	failing := validation.ValidatorFunc(func(oscalTypes.OscalModels) error { return wantErr })
+
+	var buf bytes.Buffer
+	err := WritePOAM(&buf, &oscalTypes.PlanOfActionAndMilestones{UUID: "poam-1"}, WithValidator(failing))
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, buf.Len())
+}
+
+func TestWrite_StableMode(t *testing.T) {
+	previousTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := oscalTypes.OscalModels{Catalog: testCatalog(previousTime)}
+
+	now := time.Now()
+	current := testCatalog(now)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCatalog(&buf, current, WithPrevious(previous)))
+	written, err := NewCatalog(&buf, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.True(t, previousTime.Equal(written.Metadata.LastModified))
+	// The input is not modified.
+	require.Equal(t, now, current.Metadata.LastModified)
+
+	current.Metadata.Title = "Updated Catalog"
+	buf.Reset()
+	require.NoError(t, WriteCatalog(&buf, current, WithPrevious(previous)))
+	written, err = NewCatalog(&buf, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.True(t, now.Equal(written.Metadata.LastModified))
+
+	buf.Reset()
+	require.ErrorIs(t, WriteCatalog(&buf, current, WithStableMode()), ErrNoPrevious)
+	require.Zero(t, buf.Len())
+}
+
+func TestWriteFile_StableMode(t *testing.T) {
+	previousTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{"catalog.json", "catalog.yaml", "catalog.xml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+
+			// Without a previous version, the document is written as is.
+			original := oscalTypes.OscalModels{Catalog: testCatalog(previousTime)}
+			require.NoError(t, WriteFile(path, original, WithStableMode()))
+			originalData, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			require.NoError(t, WriteFile(path, oscalTypes.OscalModels{Catalog: testCatalog(time.Now())}, WithStableMode()))
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, string(originalData), string(data))
+
+			updated := testCatalog(time.Now())
+			updated.Metadata.Version = "1.0.1"
+			require.NoError(t, WriteFile(path, oscalTypes.OscalModels{Catalog: updated}, WithStableMode()))
+			data, err = os.ReadFile(path)
+			require.NoError(t, err)
+			require.NotEqual(t, string(originalData), string(data))
+		})
+	}
+
+	err := WriteFile(filepath.Join(t.TempDir(), "catalog"), oscalTypes.OscalModels{Catalog: testCatalog(previousTime)})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
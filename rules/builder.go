@@ -0,0 +1,132 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+// ruleSetRemarksPrefix is the prefix of the remarks grouping the properties of a rule set.
+const ruleSetRemarksPrefix = "rule_set_"
+
+// RuleSetProps returns the trestle properties for RuleSets on a component of the given type.
+// It is the inverse of indexing a component in a Store.
+//
+// Validation components get a Rule_Id, Check_Id, Check_Description and Target_Component
+// group for each check. Other components get a Rule_Id, Rule_Description and Parameter_*
+// group for each rule. Multiple parameters are suffixed with their position, e.g.
+// Parameter_Id_1. Groups are numbered with zero-padded remarks, e.g. rule_set_01,
+// so they are indexed in the order of the RuleSets.
+func RuleSetProps(ruleSets []extensions.RuleSet, componentType components.ComponentType) []oscalTypes.Property {
+	var groups [][]oscalTypes.Property
+	for _, ruleSet := range ruleSets {
+		if componentType == components.Validation {
+			for _, check := range ruleSet.Checks {
+				groups = append(groups, checkGroup(ruleSet.Rule.ID, check))
+			}
+			continue
+		}
+		groups = append(groups, ruleGroup(ruleSet.Rule))
+	}
+
+	var props []oscalTypes.Property
+	width := len(strconv.Itoa(len(groups) - 1))
+	for i, group := range groups {
+		remarks := fmt.Sprintf("%s%0*d", ruleSetRemarksPrefix, width, i)
+		for _, prop := range group {
+			prop.Remarks = remarks
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// SetRuleSetProps replaces the rule set properties of the defined component with the
+// properties for the RuleSets from RuleSetProps. Other properties are kept.
+func SetRuleSetProps(component *oscalTypes.DefinedComponent, ruleSets []extensions.RuleSet) {
+	var props []oscalTypes.Property
+	if component.Props != nil {
+		for _, prop := range *component.Props {
+			if !isRuleSetProp(prop) {
+				props = append(props, prop)
+			}
+		}
+	}
+	props = append(props, RuleSetProps(ruleSets, components.ComponentType(component.Type))...)
+	if len(props) == 0 {
+		component.Props = nil
+		return
+	}
+	component.Props = &props
+}
+
+// ruleGroup returns the properties of a rule for a non-validation component.
+func ruleGroup(rule extensions.Rule) []oscalTypes.Property {
+	group := []oscalTypes.Property{trestleProp(extensions.RuleIdProp, rule.ID)}
+	if rule.Description != "" {
+		group = append(group, trestleProp(extensions.RuleDescriptionProp, rule.Description))
+	}
+	for i, parameter := range rule.Parameters {
+		// A single parameter has no suffix.
+		suffix := ""
+		if len(rule.Parameters) > 1 {
+			suffix = fmt.Sprintf("_%d", i+1)
+		}
+		group = append(group, trestleProp(extensions.ParameterIdProp+suffix, parameter.ID))
+		if parameter.Description != "" {
+			group = append(group, trestleProp(extensions.ParameterDescriptionProp+suffix, parameter.Description))
+		}
+		if parameter.Value != "" {
+			group = append(group, trestleProp(extensions.ParameterDefaultProp+suffix, parameter.Value))
+		}
+		if len(parameter.Alternatives) > 0 {
+			group = append(group, trestleProp(extensions.ParameterAlternativesProp+suffix, strings.Join(parameter.Alternatives, ", ")))
+		}
+		if parameter.Type != "" {
+			group = append(group, trestleProp(extensions.ParameterTypeProp+suffix, string(parameter.Type)))
+		}
+	}
+	return group
+}
+
+// checkGroup returns the properties of a check for a validation component.
+func checkGroup(ruleID string, check extensions.Check) []oscalTypes.Property {
+	group := []oscalTypes.Property{
+		trestleProp(extensions.RuleIdProp, ruleID),
+		trestleProp(extensions.CheckIdProp, check.ID),
+	}
+	if check.Description != "" {
+		group = append(group, trestleProp(extensions.CheckDescriptionProp, check.Description))
+	}
+	if check.TargetComponent != "" {
+		group = append(group, trestleProp(extensions.TargetComponentProp, check.TargetComponent))
+	}
+	return group
+}
+
+func trestleProp(name, value string) oscalTypes.Property {
+	return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace}
+}
+
+// isRuleSetProp returns whether the property is a trestle property that is part of a rule set.
+func isRuleSetProp(prop oscalTypes.Property) bool {
+	if !strings.Contains(prop.Ns, extensions.TrestleNameSpace) || prop.Remarks == "" {
+		return false
+	}
+	switch prop.Name {
+	case extensions.RuleIdProp, extensions.RuleDescriptionProp, extensions.CheckIdProp,
+		extensions.CheckDescriptionProp, extensions.TargetComponentProp:
+		return true
+	}
+	return strings.HasPrefix(prop.Name, "Parameter_")
+}
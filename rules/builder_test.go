@@ -0,0 +1,148 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+func TestRuleSetProps(t *testing.T) {
+	ruleSets := []extensions.RuleSet{
+		{
+			Rule: extensions.Rule{
+				ID:          "etcd_key_file",
+				Description: "Ensure that the --key-file argument is set as appropriate",
+				Parameters: []extensions.Parameter{
+					{ID: "file_name", Description: "A parameter for a file name", Value: "A default value"},
+				},
+			},
+		},
+		{
+			Rule: extensions.Rule{
+				ID:          "etcd_cert_file",
+				Description: "Ensure that the --cert-file argument is set as appropriate",
+				Parameters: []extensions.Parameter{
+					{ID: "file_name", Description: "A parameter for a file name"},
+					{ID: "file_mode", Value: "0600"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		componentType components.ComponentType
+		ruleSets      []extensions.RuleSet
+		wantProps     []oscalTypes.Property
+	}{
+		{
+			name:          "Valid/Rules",
+			componentType: components.Service,
+			ruleSets:      ruleSets,
+			wantProps: []oscalTypes.Property{
+				{Name: "Rule_Id", Value: "etcd_key_file", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Rule_Description", Value: "Ensure that the --key-file argument is set as appropriate", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Parameter_Id", Value: "file_name", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Parameter_Description", Value: "A parameter for a file name", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Parameter_Value_Default", Value: "A default value", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Rule_Id", Value: "etcd_cert_file", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Rule_Description", Value: "Ensure that the --cert-file argument is set as appropriate", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Parameter_Id_1", Value: "file_name", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Parameter_Description_1", Value: "A parameter for a file name", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Parameter_Id_2", Value: "file_mode", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Parameter_Value_Default_2", Value: "0600", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+			},
+		},
+		{
+			name:          "Valid/Checks",
+			componentType: components.Validation,
+			ruleSets: []extensions.RuleSet{
+				{
+					Rule: extensions.Rule{ID: "etcd_key_file"},
+					Checks: []extensions.Check{
+						{ID: "etcd_key_file_kubernetes", Description: "Check the key file", TargetComponent: "Kubernetes"},
+						{ID: "etcd_key_file_openshift"},
+					},
+				},
+				{Rule: extensions.Rule{ID: "etcd_cert_file"}},
+			},
+			wantProps: []oscalTypes.Property{
+				{Name: "Rule_Id", Value: "etcd_key_file", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Check_Id", Value: "etcd_key_file_kubernetes", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Check_Description", Value: "Check the key file", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Target_Component", Value: "Kubernetes", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+				{Name: "Rule_Id", Value: "etcd_key_file", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+				{Name: "Check_Id", Value: "etcd_key_file_openshift", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+			},
+		},
+		{
+			name:          "Valid/NoRuleSets",
+			componentType: components.Service,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.wantProps, RuleSetProps(c.ruleSets, c.componentType))
+		})
+	}
+}
+
+func TestRuleSetProps_RoundTrip(t *testing.T) {
+	// Enough rule sets for two digit remarks to check the index order.
+	var ruleSets []extensions.RuleSet
+	for i := 0; i < 12; i++ {
+		ruleSet := extensions.RuleSet{
+			Rule: extensions.Rule{
+				ID:          fmt.Sprintf("rule_%d", i),
+				Description: fmt.Sprintf("Rule %d", i),
+			},
+			Checks: []extensions.Check{{ID: fmt.Sprintf("check_%d", i), Description: fmt.Sprintf("Check %d", i)}},
+		}
+		for p := 0; p < i; p++ {
+			ruleSet.Rule.Parameters = append(ruleSet.Rule.Parameters, extensions.Parameter{
+				ID:           fmt.Sprintf("parameter_%d", p),
+				Value:        fmt.Sprintf("%d", p),
+				Alternatives: []string{fmt.Sprintf("%d", p), "100"},
+				Type:         extensions.ParameterTypeInteger,
+			})
+		}
+		ruleSets = append(ruleSets, ruleSet)
+	}
+
+	service := oscalTypes.DefinedComponent{
+		UUID: "service", Title: "Service", Type: string(components.Service),
+		Props: &[]oscalTypes.Property{
+			{Name: "Rule_Id", Value: "stale_rule", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+			{Name: "Parameter_Id_1", Value: "stale_parameter", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+			{Name: "label", Value: "kept"},
+		},
+	}
+	validator := oscalTypes.DefinedComponent{UUID: "validator", Title: "Validator", Type: string(components.Validation)}
+	SetRuleSetProps(&service, ruleSets)
+	SetRuleSetProps(&validator, ruleSets)
+	require.Equal(t, oscalTypes.Property{Name: "label", Value: "kept"}, (*service.Props)[0])
+
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll([]components.Component{
+		components.NewDefinedComponentAdapter(service),
+		components.NewDefinedComponentAdapter(validator),
+	}))
+	for _, want := range ruleSets {
+		got, err := testMemory.GetByRuleID(context.Background(), want.Rule.ID)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := testMemory.GetByRuleID(context.Background(), "stale_rule")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+}
@@ -0,0 +1,302 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+var (
+	// Store interface check
+	_ Store = (*FileStore)(nil)
+
+	// ErrInvalidSnapshot defines an error returned when a FileStore snapshot
+	// cannot be read.
+	ErrInvalidSnapshot = errors.New("invalid rule store snapshot")
+)
+
+// snapshotVersion is the version of the FileStore snapshot format.
+const snapshotVersion = 1
+
+// snapshot is the on-disk representation of a FileStore. The rule information parsed
+// from the components is stored, so the indexes are built without parsing the
+// component properties again.
+type snapshot struct {
+	Version int `json:"version"`
+	// Sources stores the indexed component definitions by source.
+	Sources map[string]snapshotSource `json:"sources"`
+}
+
+// snapshotSource is an indexed component definition.
+type snapshotSource struct {
+	// Digest is the SHA-256 digest of the JSON encoding of the indexed definition.
+	Digest     string              `json:"digest"`
+	Components []snapshotComponent `json:"components"`
+}
+
+// snapshotComponent is the parsed rule information of a component.
+type snapshotComponent struct {
+	UUID  string         `json:"uuid"`
+	Title string         `json:"title"`
+	Type  string         `json:"type"`
+	Rules []snapshotRule `json:"rules,omitempty"`
+}
+
+// snapshotRule is the rule information from one property group of a component.
+type snapshotRule struct {
+	RuleID      string                 `json:"rule-id"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  []extensions.Parameter `json:"parameters,omitempty"`
+	Check       *extensions.Check      `json:"check,omitempty"`
+}
+
+// FileStore implements the Store interface with the rule indexes of a MemoryStore
+// persisted as a snapshot file, so the store does not need to be rebuilt from the
+// component definitions on every start.
+//
+// Component definitions are indexed by source, e.g. the file path of the definition.
+// Indexing a source again replaces its components, and sources with unchanged content
+// are not re-indexed. Only the components of the changed source are re-indexed.
+// WARNING: This implementation is not thread safe.
+type FileStore struct {
+	path     string
+	snapshot snapshot
+	memory   *MemoryStore
+}
+
+// NewFileStore returns a FileStore persisted at the given snapshot path. An existing
+// snapshot is loaded; otherwise the store starts empty and the snapshot is created on
+// the first change.
+func NewFileStore(path string) (*FileStore, error) {
+	f := &FileStore{
+		path: path,
+		snapshot: snapshot{
+			Version: snapshotVersion,
+			Sources: make(map[string]snapshotSource),
+		},
+	}
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read rule store snapshot %q: %w", path, err)
+	default:
+		var loaded snapshot
+		if err := json.Unmarshal(data, &loaded); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidSnapshot, path, err)
+		}
+		if loaded.Version != snapshotVersion {
+			return nil, fmt.Errorf("%w %q: unsupported version %d", ErrInvalidSnapshot, path, loaded.Version)
+		}
+		if loaded.Sources != nil {
+			f.snapshot.Sources = loaded.Sources
+		}
+	}
+
+	f.rebuild()
+	return f, nil
+}
+
+// Sources returns the sources of the indexed component definitions.
+func (f *FileStore) Sources() []string {
+	return sortedKeys(f.snapshot.Sources)
+}
+
+// IndexDefinition indexes the components of a component definition under the given source,
+// replacing any components previously indexed for the source, and saves the snapshot.
+func (f *FileStore) IndexDefinition(source string, definition oscalTypes.ComponentDefinition) error {
+	_, err := f.index(source, definition)
+	return err
+}
+
+// IndexDefinitionFile indexes the component definition at the given path with the path as
+// the source. It returns whether the source was re-indexed.
+func (f *FileStore) IndexDefinitionFile(path string) (bool, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return false, fmt.Errorf("failed to index source %q: %w", path, err)
+	}
+	definition, err := models.LoadAs[oscalTypes.ComponentDefinition](bytes.NewReader(content), validation.NoopValidator{}, models.WithFormat(models.FormatFromPath(path)))
+	if err != nil {
+		return false, fmt.Errorf("failed to index source %q: %w", path, err)
+	}
+	return f.index(path, *definition)
+}
+
+// RemoveDefinition removes the components indexed for the given source and saves the snapshot.
+func (f *FileStore) RemoveDefinition(source string) error {
+	if _, ok := f.snapshot.Sources[source]; !ok {
+		return nil
+	}
+	f.memory.replaceSource(source, nil)
+	delete(f.snapshot.Sources, source)
+	return f.save()
+}
+
+// index replaces the components of the source in the rule indexes and the snapshot. Sources
+// are only re-indexed if the digest of the definition changed. It returns whether the source
+// was re-indexed.
+func (f *FileStore) index(source string, definition oscalTypes.ComponentDefinition) (bool, error) {
+	digest, err := digestOf(definition)
+	if err != nil {
+		return false, fmt.Errorf("failed to index source %q: %w", source, err)
+	}
+	if existing, ok := f.snapshot.Sources[source]; ok && existing.Digest == digest {
+		return false, nil
+	}
+
+	var parsed []indexedComponent
+	if definition.Components != nil {
+		comps := make([]components.Component, 0, len(*definition.Components))
+		for _, comp := range *definition.Components {
+			comps = append(comps, components.NewDefinedComponentAdapter(comp))
+		}
+		parsed = parseComponents(comps)
+	}
+
+	indexed := snapshotSource{Digest: digest}
+	for _, comp := range parsed {
+		indexed.Components = append(indexed.Components, toSnapshotComponent(comp))
+	}
+	f.memory.replaceSource(source, parsed)
+	f.snapshot.Sources[source] = indexed
+	return true, f.save()
+}
+
+// rebuild creates the rule indexes from the parsed components in the snapshot.
+func (f *FileStore) rebuild() {
+	var parsed []indexedComponent
+	for _, source := range f.Sources() {
+		for _, comp := range f.snapshot.Sources[source].Components {
+			parsed = append(parsed, fromSnapshotComponent(source, comp))
+		}
+	}
+	f.memory = newMemoryStoreFrom(parsed)
+}
+
+// toSnapshotComponent returns the snapshot representation of a parsed component.
+func toSnapshotComponent(indexed indexedComponent) snapshotComponent {
+	comp := snapshotComponent{
+		UUID:  indexed.uuid,
+		Title: indexed.title,
+		Type:  string(indexed.componentType),
+	}
+	for _, fragment := range indexed.fragments {
+		rule := snapshotRule{
+			RuleID:      fragment.ruleID,
+			Description: fragment.description,
+			Parameters:  fragment.parameters,
+		}
+		if fragment.check != (extensions.Check{}) {
+			check := fragment.check
+			rule.Check = &check
+		}
+		comp.Rules = append(comp.Rules, rule)
+	}
+	return comp
+}
+
+// fromSnapshotComponent returns the parsed component of a source from its snapshot representation.
+func fromSnapshotComponent(source string, comp snapshotComponent) indexedComponent {
+	indexed := indexedComponent{
+		source:        source,
+		uuid:          comp.UUID,
+		title:         comp.Title,
+		componentType: components.ComponentType(comp.Type),
+	}
+	for _, rule := range comp.Rules {
+		fragment := ruleFragment{
+			ruleID:      rule.RuleID,
+			description: rule.Description,
+			parameters:  rule.Parameters,
+		}
+		if rule.Check != nil {
+			fragment.check = *rule.Check
+		}
+		indexed.fragments = append(indexed.fragments, fragment)
+	}
+	return indexed
+}
+
+// digestOf returns the SHA-256 digest of the JSON encoding of the component definition,
+// so a definition has the same digest whether it is indexed from a file or decoded.
+func digestOf(definition oscalTypes.ComponentDefinition) (string, error) {
+	content, err := json.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// save writes the snapshot atomically by replacing the file.
+func (f *FileStore) save() error {
+	data, err := json.Marshal(f.snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to save rule store snapshot: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to save rule store snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to save rule store snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save rule store snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("failed to save rule store snapshot: %w", err)
+	}
+	return nil
+}
+
+func (f *FileStore) GetByRuleID(ctx context.Context, ruleId string) (extensions.RuleSet, error) {
+	return f.memory.GetByRuleID(ctx, ruleId)
+}
+
+func (f *FileStore) GetByCheckID(ctx context.Context, checkId string) (extensions.RuleSet, error) {
+	return f.memory.GetByCheckID(ctx, checkId)
+}
+
+func (f *FileStore) FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error) {
+	return f.memory.FindByComponent(ctx, componentId)
+}
+
+func (f *FileStore) FindByComponentTitle(ctx context.Context, title string) ([]extensions.RuleSet, error) {
+	return f.memory.FindByComponentTitle(ctx, title)
+}
+
+func (f *FileStore) FindByComponentUUID(ctx context.Context, componentUUID string) ([]extensions.RuleSet, error) {
+	return f.memory.FindByComponentUUID(ctx, componentUUID)
+}
+
+func (f *FileStore) FindByComponentType(ctx context.Context, componentType components.ComponentType) ([]extensions.RuleSet, error) {
+	return f.memory.FindByComponentType(ctx, componentType)
+}
+
+func (f *FileStore) Query(ctx context.Context, opts ...QueryOption) (QueryResult, error) {
+	return f.memory.Query(ctx, opts...)
+}
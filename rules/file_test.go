@@ -0,0 +1,184 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestFileStore(t *testing.T) {
+	testCtx := context.Background()
+	dir := t.TempDir()
+	snapshotPath := filepath.Join(dir, "rules.json")
+	definitionPath := filepath.Join(dir, "component-definition.json")
+	content, err := os.ReadFile("../testdata/component-definition-test.json")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(definitionPath, content, 0600))
+
+	store, err := NewFileStore(snapshotPath)
+	require.NoError(t, err)
+	_, err = store.FindByComponent(testCtx, "TestKubernetes")
+	require.EqualError(t, err, "failed to find rules for component \"TestKubernetes\"")
+
+	indexed, err := store.IndexDefinitionFile(definitionPath)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	require.Equal(t, []string{definitionPath}, store.Sources())
+
+	// Unchanged sources are not re-indexed.
+	indexed, err = store.IndexDefinitionFile(definitionPath)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	// The same semantics as the MemoryStore.
+	memoryStore := prepMemoryStore(t)
+	for _, componentId := range []string{"TestKubernetes", "Validator", "Validator2"} {
+		want, err := memoryStore.FindByComponent(testCtx, componentId)
+		require.NoError(t, err)
+		got, err := store.FindByComponent(testCtx, componentId)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	ruleSet, err := store.GetByCheckID(testCtx, "etcd_key_file")
+	require.NoError(t, err)
+	require.Equal(t, expectedKeyFileRule, ruleSet)
+
+	// A new store starts from the snapshot.
+	reopened, err := NewFileStore(snapshotPath)
+	require.NoError(t, err)
+	require.Equal(t, []string{definitionPath}, reopened.Sources())
+	ruleSet, err = reopened.GetByRuleID(testCtx, "etcd_cert_file")
+	require.NoError(t, err)
+	require.Equal(t, expectedCertFileRule, ruleSet)
+
+	// Changed sources replace their components.
+	updated := strings.ReplaceAll(string(content), "etcd_cert_file", "etcd_cert_file_v2")
+	require.NoError(t, os.WriteFile(definitionPath, []byte(updated), 0600))
+	indexed, err = reopened.IndexDefinitionFile(definitionPath)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	_, err = reopened.GetByRuleID(testCtx, "etcd_cert_file")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+	_, err = reopened.GetByRuleID(testCtx, "etcd_cert_file_v2")
+	require.NoError(t, err)
+
+	require.NoError(t, reopened.RemoveDefinition(definitionPath))
+	require.Empty(t, reopened.Sources())
+	_, err = reopened.GetByRuleID(testCtx, "etcd_key_file")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+
+	reopened, err = NewFileStore(snapshotPath)
+	require.NoError(t, err)
+	require.Empty(t, reopened.Sources())
+
+	// Decoded definitions are indexed with a caller-defined source.
+	file, err := os.Open("../testdata/component-definition-test2.json")
+	require.NoError(t, err)
+	defer file.Close()
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NoError(t, reopened.IndexDefinition("vendor/definition", *definition))
+	require.Equal(t, []string{"vendor/definition"}, reopened.Sources())
+	_, err = reopened.GetByRuleID(testCtx, "example_rule_1")
+	require.NoError(t, err)
+
+	// Definitions have the same digest whether they are decoded or indexed from a file.
+	content, err = os.ReadFile("../testdata/component-definition-test2.json")
+	require.NoError(t, err)
+	vendorPath := filepath.Join(dir, "vendor-definition.json")
+	require.NoError(t, os.WriteFile(vendorPath, content, 0600))
+	indexed, err = reopened.IndexDefinitionFile(vendorPath)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	require.Equal(t, reopened.snapshot.Sources["vendor/definition"].Digest, reopened.snapshot.Sources[vendorPath].Digest)
+
+	// Components indexed for another source are kept when a source is removed.
+	require.NoError(t, reopened.RemoveDefinition("vendor/definition"))
+	_, err = reopened.GetByRuleID(testCtx, "example_rule_1")
+	require.NoError(t, err)
+	require.NoError(t, reopened.RemoveDefinition(vendorPath))
+	_, err = reopened.GetByRuleID(testCtx, "example_rule_1")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+}
+
+func TestNewFileStore_InvalidSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "Invalid/JSON", content: "{"},
+		{name: "Invalid/Version", content: `{"version": 0, "sources": {}}`},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rules.json")
+			require.NoError(t, os.WriteFile(path, []byte(c.content), 0600))
+			_, err := NewFileStore(path)
+			require.ErrorIs(t, err, ErrInvalidSnapshot)
+		})
+	}
+}
+
+func TestFileStore_SameComponentInTwoSources(t *testing.T) {
+	testCtx := context.Background()
+	snapshotPath := filepath.Join(t.TempDir(), "rules.json")
+	definitionOf := func(ruleIDs ...string) oscalTypes.ComponentDefinition {
+		var ruleSets []extensions.RuleSet
+		for _, ruleID := range ruleIDs {
+			ruleSets = append(ruleSets, extensions.RuleSet{Rule: extensions.Rule{ID: ruleID, Description: ruleID}})
+		}
+		props := RuleSetProps(ruleSets, components.Service)
+		return oscalTypes.ComponentDefinition{
+			Components: &[]oscalTypes.DefinedComponent{
+				{UUID: "shared", Title: "Kubernetes", Type: string(components.Service), Props: &props},
+			},
+		}
+	}
+	ruleIDsOf := func(t *testing.T, store Store) []string {
+		ruleSets, err := store.FindByComponentUUID(testCtx, "shared")
+		require.NoError(t, err)
+		var ruleIDs []string
+		for _, ruleSet := range ruleSets {
+			ruleIDs = append(ruleIDs, ruleSet.Rule.ID)
+		}
+		return ruleIDs
+	}
+
+	store, err := NewFileStore(snapshotPath)
+	require.NoError(t, err)
+	require.NoError(t, store.IndexDefinition("a", definitionOf("rule_a")))
+	require.NoError(t, store.IndexDefinition("b", definitionOf("rule_b")))
+	require.Equal(t, []string{"rule_a", "rule_b"}, ruleIDsOf(t, store))
+
+	// Re-indexing a source keeps the component indexed for the other source.
+	require.NoError(t, store.IndexDefinition("a", definitionOf("rule_a2")))
+	require.Equal(t, []string{"rule_a2", "rule_b"}, ruleIDsOf(t, store))
+	_, err = store.GetByRuleID(testCtx, "rule_a")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+
+	// The snapshot stores the parsed rules, so a new store has the same indexes.
+	reopened, err := NewFileStore(snapshotPath)
+	require.NoError(t, err)
+	require.Equal(t, []string{"rule_a2", "rule_b"}, ruleIDsOf(t, reopened))
+	ruleSet, err := reopened.GetByRuleID(testCtx, "rule_b")
+	require.NoError(t, err)
+	require.Equal(t, extensions.RuleSet{Rule: extensions.Rule{ID: "rule_b", Description: "rule_b"}}, ruleSet)
+
+	require.NoError(t, reopened.RemoveDefinition("a"))
+	require.Equal(t, []string{"rule_b"}, ruleIDsOf(t, reopened))
+}
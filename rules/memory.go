@@ -9,8 +9,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 
@@ -21,20 +24,39 @@ import (
 var (
 	// Store interface check
 	_ Store = (*MemoryStore)(nil)
+	_ Store = (*memoryIndex)(nil)
 
 	// ErrRuleNotFound defines an error returned when rule queries fail.
 	ErrRuleNotFound = errors.New("associated rule object not found")
 	// ErrComponentsNotFound defines an error returned during MemoryStore creation when the input
 	// is invalid.
 	ErrComponentsNotFound = errors.New("no components not found")
+	// ErrComponentNotIndexed defines an error returned when removing a component
+	// that is not in the MemoryStore.
+	ErrComponentNotIndexed = errors.New("component not indexed")
 )
 
 // MemoryStore implements the Store interface using an in-memory map-based data structure.
-// WARNING: This implementation is not thread safe.
+// It is safe for concurrent use. Queries read an immutable snapshot of the indexes, and
+// changes build a new snapshot that replaces the current one when complete, so readers
+// never observe a partially indexed component. Changes rebuild the indexes from the
+// parsed components, which favors read-heavy use.
 type MemoryStore struct {
+	// mu serializes changes to the store. Readers do not lock.
+	mu sync.Mutex
+	// current is the latest snapshot of the indexes.
+	current atomic.Pointer[memoryIndex]
+}
+
+// memoryIndex is an immutable snapshot of the MemoryStore indexes.
+type memoryIndex struct {
 	// nodes saves the rule ID map keys, which are used with
-	// the other fields.
+	// the other fields. The rule information of all components
+	// with the same rule ID is merged.
 	nodes map[string]extensions.RuleSet
+	// ruleSetsByComponentUUID stores the component UUID mapped to the
+	// rule information defined by that component only, keyed by rule ID.
+	ruleSetsByComponentUUID map[string]map[string]extensions.RuleSet
 	// ByCheck store a mapping between the checkId and its parent
 	// ruleId
 	byCheck map[string]string
@@ -48,16 +70,54 @@ type MemoryStore struct {
 	// checksByValidationComponent store checkId mapped to validation
 	// component title to filter check information on rules.
 	checksByValidationComponent map[string]set.Set[string]
+	// rulesByComponentUUID stores the component UUID of any component
+	// mapped to any relevant rules.
+	rulesByComponentUUID map[string]set.Set[string]
+	// checksByValidationComponentUUID store checkId mapped to validation
+	// component UUID to filter check information on rules.
+	checksByValidationComponentUUID map[string]set.Set[string]
+	// rulesByTargetComponent stores the target component title of checks
+	// scoped to a target component mapped to the rules of the checks.
+	rulesByTargetComponent map[string]set.Set[string]
+	// componentsByType stores the component type mapped to the
+	// UUIDs of the components.
+	componentsByType map[components.ComponentType]set.Set[string]
+	// titlesByComponentUUID stores the component UUID mapped to the
+	// component title.
+	titlesByComponentUUID map[string]string
+
+	// components stores the parsed rule information of the indexed
+	// components in index order to build the maps above.
+	components []indexedComponent
 }
 
 // NewMemoryStore creates a new memory-based Store.
 func NewMemoryStore() *MemoryStore {
-	return &MemoryStore{
-		nodes:                       make(map[string]extensions.RuleSet),
-		byCheck:                     make(map[string]string),
-		rulesByComponent:            make(map[string]set.Set[string]),
-		checksByValidationComponent: make(map[string]set.Set[string]),
+	m := &MemoryStore{}
+	m.current.Store(newMemoryIndex(nil))
+	return m
+}
+
+// newMemoryStoreFrom creates a MemoryStore with the indexes built from parsed components.
+func newMemoryStoreFrom(parsed []indexedComponent) *MemoryStore {
+	m := &MemoryStore{}
+	m.current.Store(newMemoryIndex(parsed))
+	return m
+}
+
+// snapshot returns the current snapshot of the indexes.
+func (m *MemoryStore) snapshot() *memoryIndex {
+	if index := m.current.Load(); index != nil {
+		return index
 	}
+	return newMemoryIndex(nil)
+}
+
+// Snapshot returns a read-only Store with the rule information indexed at the time of the call.
+// Changes to the MemoryStore are not visible in the snapshot, which gives a consistent
+// view across several queries.
+func (m *MemoryStore) Snapshot() Store {
+	return m.snapshot()
 }
 
 // IndexAll indexes rule information from OSCAL Components.
@@ -65,69 +125,269 @@ func (m *MemoryStore) IndexAll(components []components.Component) error {
 	if len(components) == 0 {
 		return fmt.Errorf("failed to index components: %w", ErrComponentsNotFound)
 	}
-	for _, component := range components {
+	parsed := parseComponents(components)
 
-		// Catalog information here at the component in the MemoryStore at the
-		// component level
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current := m.snapshot()
+	m.current.Store(newMemoryIndex(append(slices.Clip(current.components), parsed...)))
+	return nil
+}
 
-		componentTitle := component.Title()
-		extractedRules, extractedChecks := m.indexComponent(component)
-		if len(extractedRules) != 0 {
-			existingRules, ok := m.rulesByComponent[componentTitle]
-			if ok {
-				for rule := range existingRules {
-					extractedRules.Add(rule)
-				}
-			}
-			m.rulesByComponent[component.Title()] = extractedRules
+// Unindex removes the components with the given UUIDs and their rule information
+// from the store. Rules and checks that no remaining component references are
+// removed as well.
+func (m *MemoryStore) Unindex(componentUUIDs ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current := m.snapshot()
+
+	var errs []error
+	indexed := current.componentUUIDs(defaultSource)
+	toRemove := set.New[string]()
+	for _, componentUUID := range componentUUIDs {
+		if !indexed.Has(componentUUID) {
+			errs = append(errs, fmt.Errorf("component %q: %w", componentUUID, ErrComponentNotIndexed))
 		}
-		existingRules, ok := m.rulesByComponent[componentTitle]
-		if ok {
-			for rule := range existingRules {
-				extractedRules.Add(rule)
-			}
+		toRemove.Add(componentUUID)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to unindex components: %w", errors.Join(errs...))
+	}
+	m.current.Store(newMemoryIndex(current.without(defaultSource, toRemove)))
+	return nil
+}
+
+// Reindex replaces the rule information of previously indexed components with the
+// rule information of the given components, which are matched by UUID. Components
+// that were not indexed before are added.
+func (m *MemoryStore) Reindex(components []components.Component) error {
+	if len(components) == 0 {
+		return fmt.Errorf("failed to reindex components: %w", ErrComponentsNotFound)
+	}
+	parsed := parseComponents(components)
+	toRemove := set.New[string]()
+	for _, indexed := range parsed {
+		toRemove.Add(indexed.uuid)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current := m.snapshot()
+	m.current.Store(newMemoryIndex(append(current.without(defaultSource, toRemove), parsed...)))
+	return nil
+}
+
+// replaceSource replaces the parsed components of the source with the given components.
+// Components indexed for other sources are kept, even if they have the same UUID.
+func (m *MemoryStore) replaceSource(source string, parsed []indexedComponent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current := m.snapshot()
+	remaining := make([]indexedComponent, 0, len(current.components)+len(parsed))
+	for _, indexed := range current.components {
+		if indexed.source != source {
+			remaining = append(remaining, indexed)
 		}
+	}
+	for _, indexed := range parsed {
+		indexed.source = source
+		remaining = append(remaining, indexed)
+	}
+	m.current.Store(newMemoryIndex(remaining))
+}
 
-		if len(extractedChecks) != 0 {
-			existingChecks, ok := m.checksByValidationComponent[componentTitle]
-			if ok {
-				for check := range existingChecks {
-					extractedChecks.Add(check)
-				}
+func (m *MemoryStore) GetByRuleID(ctx context.Context, ruleId string) (extensions.RuleSet, error) {
+	return m.snapshot().GetByRuleID(ctx, ruleId)
+}
+
+func (m *MemoryStore) GetByCheckID(ctx context.Context, checkId string) (extensions.RuleSet, error) {
+	return m.snapshot().GetByCheckID(ctx, checkId)
+}
+
+// FindByComponent returns RuleSets associated with the component title.
+// It is an alias for FindByComponentTitle.
+func (m *MemoryStore) FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error) {
+	return m.snapshot().FindByComponent(ctx, componentId)
+}
+
+// FindByComponentTitle returns RuleSets associated with the component title.
+// The rules of all components with the same title are returned.
+func (m *MemoryStore) FindByComponentTitle(ctx context.Context, title string) ([]extensions.RuleSet, error) {
+	return m.snapshot().FindByComponentTitle(ctx, title)
+}
+
+// FindByComponentUUID returns RuleSets associated with the component UUID.
+func (m *MemoryStore) FindByComponentUUID(ctx context.Context, componentUUID string) ([]extensions.RuleSet, error) {
+	return m.snapshot().FindByComponentUUID(ctx, componentUUID)
+}
+
+// FindByComponentType returns RuleSets associated with any component of the component type.
+func (m *MemoryStore) FindByComponentType(ctx context.Context, componentType components.ComponentType) ([]extensions.RuleSet, error) {
+	return m.snapshot().FindByComponentType(ctx, componentType)
+}
+
+// newMemoryIndex builds the indexes for the parsed components.
+func newMemoryIndex(parsed []indexedComponent) *memoryIndex {
+	m := &memoryIndex{
+		nodes:                           make(map[string]extensions.RuleSet),
+		ruleSetsByComponentUUID:         make(map[string]map[string]extensions.RuleSet),
+		byCheck:                         make(map[string]string),
+		rulesByComponent:                make(map[string]set.Set[string]),
+		checksByValidationComponent:     make(map[string]set.Set[string]),
+		rulesByComponentUUID:            make(map[string]set.Set[string]),
+		checksByValidationComponentUUID: make(map[string]set.Set[string]),
+		rulesByTargetComponent:          make(map[string]set.Set[string]),
+		componentsByType:                make(map[components.ComponentType]set.Set[string]),
+		titlesByComponentUUID:           make(map[string]string),
+		components:                      parsed,
+	}
+	for _, indexed := range parsed {
+		m.add(indexed)
+	}
+	return m
+}
+
+// without returns the parsed components except the components of the source with the given UUIDs.
+func (m *memoryIndex) without(source string, componentUUIDs set.Set[string]) []indexedComponent {
+	remaining := make([]indexedComponent, 0, len(m.components))
+	for _, indexed := range m.components {
+		if indexed.source != source || !componentUUIDs.Has(indexed.uuid) {
+			remaining = append(remaining, indexed)
+		}
+	}
+	return remaining
+}
+
+// componentUUIDs returns the UUIDs of the components indexed for the source.
+func (m *memoryIndex) componentUUIDs(source string) set.Set[string] {
+	uuids := set.New[string]()
+	for _, indexed := range m.components {
+		if indexed.source == source {
+			uuids.Add(indexed.uuid)
+		}
+	}
+	return uuids
+}
+
+// add adds the rule information of an indexed component to the indexes.
+func (m *memoryIndex) add(indexed indexedComponent) {
+	m.titlesByComponentUUID[indexed.uuid] = indexed.title
+	addToIndex(m.componentsByType, indexed.componentType, indexed.uuid)
+	componentRuleSets, ok := m.ruleSetsByComponentUUID[indexed.uuid]
+	if !ok {
+		componentRuleSets = make(map[string]extensions.RuleSet)
+		m.ruleSetsByComponentUUID[indexed.uuid] = componentRuleSets
+	}
+
+	for _, fragment := range indexed.fragments {
+		mergeFragment(m.nodes, fragment)
+		mergeFragment(componentRuleSets, fragment)
+
+		if fragment.check.ID != "" {
+			m.byCheck[fragment.check.ID] = fragment.ruleID
+			addToIndex(m.checksByValidationComponent, indexed.title, fragment.check.ID)
+			addToIndex(m.checksByValidationComponentUUID, indexed.uuid, fragment.check.ID)
+			// Checks scoped to a target component make the rule available to
+			// the target component as well.
+			if fragment.check.TargetComponent != "" {
+				addToIndex(m.rulesByTargetComponent, fragment.check.TargetComponent, fragment.ruleID)
 			}
-			m.checksByValidationComponent[componentTitle] = extractedChecks
 		}
+		addToIndex(m.rulesByComponent, indexed.title, fragment.ruleID)
+		addToIndex(m.rulesByComponentUUID, indexed.uuid, fragment.ruleID)
 	}
-	return nil
 }
 
-// indexComponent returns extracted rules and checks (respectively) from a given component.
-func (m *MemoryStore) indexComponent(component components.Component) (set.Set[string], set.Set[string]) {
+// mergeFragment merges the rule information of the fragment into the RuleSet with the
+// same rule ID.
+func mergeFragment(ruleSets map[string]extensions.RuleSet, fragment ruleFragment) {
+	ruleSet := ruleSets[fragment.ruleID]
+	ruleSet.Rule.ID = fragment.ruleID
+	if fragment.description != "" {
+		ruleSet.Rule.Description = fragment.description
+	}
+	if len(fragment.parameters) > 0 {
+		ruleSet.Rule.Parameters = fragment.parameters
+	}
+	if fragment.check.ID != "" {
+		// Checks are copied so snapshots never share a backing array.
+		ruleSet.Checks = append(slices.Clip(ruleSet.Checks), fragment.check)
+	}
+	ruleSets[fragment.ruleID] = ruleSet
+}
+
+// addToIndex adds the value to the set stored for the key in the index.
+func addToIndex[K comparable](index map[K]set.Set[string], key K, value string) {
+	values, ok := index[key]
+	if !ok {
+		values = set.New[string]()
+		index[key] = values
+	}
+	values.Add(value)
+}
+
+// defaultSource is the source of components indexed with IndexAll and Reindex.
+const defaultSource = ""
+
+// indexedComponent stores the rule information parsed from an indexed component.
+type indexedComponent struct {
+	// source identifies where the component was indexed from, so the same
+	// component can be indexed for several sources.
+	source        string
+	uuid          string
+	title         string
+	componentType components.ComponentType
+	// fragments are the rule sets of the component in group order.
+	fragments []ruleFragment
+}
+
+// ruleFragment is the rule information from one property group of a component.
+// The fragments with the same rule ID are merged into a RuleSet per component and
+// into a RuleSet for all components.
+type ruleFragment struct {
+	ruleID      string
+	description string
+	parameters  []extensions.Parameter
+	// check is only set for validation components.
+	check extensions.Check
+}
+
+// parseComponents returns the rule information from the given components.
+func parseComponents(components []components.Component) []indexedComponent {
+	parsed := make([]indexedComponent, 0, len(components))
+	for _, component := range components {
+		parsed = append(parsed, parseComponent(component))
+	}
+	return parsed
+}
+
+// parseComponent returns the rule information from a given component.
+func parseComponent(component components.Component) indexedComponent {
 	// Catalog all registered rules for all components and check implementations by validation component for filtering in
 	// `rules.FindByComponent`.
-	rules := set.New[string]()
-	checks := set.New[string]()
+	indexed := indexedComponent{
+		uuid:          component.UUID(),
+		title:         component.Title(),
+		componentType: component.Type(),
+	}
 
 	if len(component.Props()) == 0 {
-		return rules, checks
+		return indexed
 	}
 
 	// Each rule set is linked by a group id in the property remarks
+	// Rule sets are processed in order of their group id so checks and
+	// parameters are indexed in a stable order.
 	byRemarks := groupPropsByRemarks(component.Props())
-	for _, propSet := range byRemarks {
+	for _, remarks := range sortedKeys(byRemarks) {
+		propSet := byRemarks[remarks]
 		ruleIdProp, ok := getProp(extensions.RuleIdProp, propSet)
 		if !ok {
 			continue
 		}
 
-		ruleSet, ok := m.nodes[ruleIdProp.Value]
-		if !ok {
-			ruleSet = extensions.RuleSet{}
-		}
-
-		// A check may or may not be registered depending on
-		// component.Type().
-		placeholderCheck := extensions.Check{}
+		fragment := ruleFragment{ruleID: ruleIdProp.Value}
 
 		// paramMap stores a map of parameters to their suffix value
 		// of the property name.  Multiple properties contain
@@ -135,19 +395,20 @@ func (m *MemoryStore) indexComponent(component components.Component) (set.Set[st
 		// iterates over the properties the parameters stored
 		// in the map are populated.
 		paramMap := make(map[string]extensions.Parameter)
+		// defaults stores the default values by suffix, which are set once
+		// the parameter type is known.
+		defaults := make(map[string]string)
 
 		for prop := range propSet {
 			var propName string
 			var propSuffix string
 
-			// Matches any parameter properties that have a numerical suffix
-			re := regexp.MustCompile(`^Parameter_.*\d+$`)
 			// If the property name contains "Parameter" and has a numerical
 			// suffix then extract the suffix as the key for the map.
 			// Otherwise default to using "0" as the key (meaning there is
 			// no numerical suffix and only one parameter contained
 			// in the properties).
-			if re.MatchString(prop.Name) {
+			if extensions.ParameterSuffixRegex.MatchString(prop.Name) {
 				// Split the property name to handle properties that have
 				// a numerical suffix.  e.g Parameter_Id_1
 				propNameParts := strings.Split(prop.Name, "_")
@@ -160,70 +421,66 @@ func (m *MemoryStore) indexComponent(component components.Component) (set.Set[st
 			}
 
 			switch propName {
-			case extensions.RuleIdProp:
-				ruleSet.Rule.ID = prop.Value
 			case extensions.RuleDescriptionProp:
-				ruleSet.Rule.Description = prop.Value
+				fragment.description = prop.Value
 			case extensions.CheckIdProp:
-				placeholderCheck.ID = prop.Value
+				fragment.check.ID = prop.Value
 			case extensions.CheckDescriptionProp:
-				placeholderCheck.Description = prop.Value
+				fragment.check.Description = prop.Value
+			case extensions.TargetComponentProp:
+				fragment.check.TargetComponent = prop.Value
 			case extensions.ParameterIdProp:
-				p, ok := paramMap[propSuffix]
-				if !ok {
-					paramMap[propSuffix] = extensions.Parameter{
-						ID: prop.Value,
-					}
-				} else {
-					p.ID = prop.Value
-					paramMap[propSuffix] = p
-				}
+				p := paramMap[propSuffix]
+				p.ID = prop.Value
+				paramMap[propSuffix] = p
 			case extensions.ParameterDescriptionProp:
-				p, ok := paramMap[propSuffix]
-				if !ok {
-					paramMap[propSuffix] = extensions.Parameter{
-						Description: prop.Value,
-					}
-				} else {
-					p.Description = prop.Value
-					paramMap[propSuffix] = p
-				}
+				p := paramMap[propSuffix]
+				p.Description = prop.Value
+				paramMap[propSuffix] = p
 			case extensions.ParameterDefaultProp:
-				p, ok := paramMap[propSuffix]
-
-				if !ok {
-					paramMap[propSuffix] = extensions.Parameter{
-						Value: prop.Value,
-					}
-				} else {
-					p.Value = prop.Value
-					paramMap[propSuffix] = p
-				}
+				paramMap[propSuffix] = paramMap[propSuffix]
+				defaults[propSuffix] = prop.Value
+			case extensions.ParameterAlternativesProp:
+				p := paramMap[propSuffix]
+				p.Alternatives = extensions.SplitList(prop.Value)
+				paramMap[propSuffix] = p
+			case extensions.ParameterTypeProp:
+				p := paramMap[propSuffix]
+				p.Type = extensions.ParameterType(prop.Value)
+				paramMap[propSuffix] = p
 			}
 		}
 
+		// Only the defaults of list parameters have multiple values.
+		for suffix, value := range defaults {
+			p := paramMap[suffix]
+			if p.Type == extensions.ParameterTypeList {
+				p.SetValues(extensions.SplitList(value)...)
+			} else {
+				p.Value = value
+			}
+			paramMap[suffix] = p
+		}
+
 		// Add any parameters that were extracted from the
 		// properties to the rule
 		if len(paramMap) > 0 {
-			ruleParams := make([]extensions.Parameter, 0, len(paramMap))
-			ruleSet.Rule.Parameters = ruleParams
-			for _, param := range paramMap {
-				ruleSet.Rule.Parameters = append(ruleSet.Rule.Parameters, param)
+			suffixes := sortedKeys(paramMap)
+			// Numerical suffixes are ordered by value.
+			sort.SliceStable(suffixes, func(i, j int) bool {
+				return len(suffixes[i]) < len(suffixes[j])
+			})
+			fragment.parameters = make([]extensions.Parameter, 0, len(paramMap))
+			for _, suffix := range suffixes {
+				fragment.parameters = append(fragment.parameters, paramMap[suffix])
 			}
 		}
-
-		if placeholderCheck.ID != "" {
-			ruleSet.Checks = append(ruleSet.Checks, placeholderCheck)
-			m.byCheck[placeholderCheck.ID] = ruleSet.Rule.ID
-			checks.Add(placeholderCheck.ID)
-		}
-		rules.Add(ruleSet.Rule.ID)
-		m.nodes[ruleSet.Rule.ID] = ruleSet
+		indexed.fragments = append(indexed.fragments, fragment)
 	}
-	return rules, checks
+	return indexed
 }
 
-func (m *MemoryStore) GetByRuleID(_ context.Context, ruleId string) (extensions.RuleSet, error) {
+func (m *memoryIndex) GetByRuleID(_ context.Context, ruleId string) (extensions.RuleSet, error) {
 	ruleSet, ok := m.nodes[ruleId]
 	if !ok {
 		return extensions.RuleSet{}, fmt.Errorf("rule %q: %w", ruleId, ErrRuleNotFound)
@@ -231,7 +488,7 @@ func (m *MemoryStore) GetByRuleID(_ context.Context, ruleId string) (extensions.
 	return ruleSet, nil
 }
 
-func (m *MemoryStore) GetByCheckID(ctx context.Context, checkId string) (extensions.RuleSet, error) {
+func (m *memoryIndex) GetByCheckID(ctx context.Context, checkId string) (extensions.RuleSet, error) {
 	ruleId, ok := m.byCheck[checkId]
 	if !ok {
 		return extensions.RuleSet{}, fmt.Errorf("failed to find rule for check %q: %w", checkId, ErrRuleNotFound)
@@ -239,26 +496,134 @@ func (m *MemoryStore) GetByCheckID(ctx context.Context, checkId string) (extensi
 	return m.GetByRuleID(ctx, ruleId)
 }
 
-func (m *MemoryStore) FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error) {
-	ruleIds, ok := m.rulesByComponent[componentId]
-	if !ok {
-		return nil, fmt.Errorf("failed to find rules for component %q", componentId)
+func (m *memoryIndex) FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error) {
+	return m.FindByComponentTitle(ctx, componentId)
+}
+
+func (m *memoryIndex) FindByComponentTitle(ctx context.Context, title string) ([]extensions.RuleSet, error) {
+	lookup := componentLookup{
+		rules:            union(m.rulesByComponent[title], m.rulesByTargetComponent[title]),
+		validationChecks: m.checksByValidationComponent[title],
+		titles:           set.New[string](),
+	}
+	lookup.titles.Add(title)
+	return m.findRules(ctx, fmt.Sprintf("component %q", title), lookup)
+}
+
+// FindByComponentUUID returns the RuleSets defined by the component. The rule information is
+// taken from the component only, so components sharing a rule ID do not affect each other.
+func (m *memoryIndex) FindByComponentUUID(_ context.Context, componentUUID string) ([]extensions.RuleSet, error) {
+	ruleSets := m.componentRuleSets(componentUUID)
+	if len(ruleSets) == 0 {
+		return nil, fmt.Errorf("failed to find rules for component %q", componentUUID)
+	}
+	return sortedRuleSets(ruleSets), nil
+}
+
+// FindByComponentType returns the RuleSets defined by the components of the type. For rules
+// defined by several components, the rule information is taken from the first component in
+// UUID order and the checks of all components are returned.
+func (m *memoryIndex) FindByComponentType(_ context.Context, componentType components.ComponentType) ([]extensions.RuleSet, error) {
+	merged := make(map[string]extensions.RuleSet)
+	for _, componentUUID := range sortedKeys(m.componentsByType[componentType]) {
+		for ruleID, ruleSet := range m.componentRuleSets(componentUUID) {
+			existing, ok := merged[ruleID]
+			if !ok {
+				merged[ruleID] = ruleSet
+				continue
+			}
+			for _, check := range ruleSet.Checks {
+				if !slices.ContainsFunc(existing.Checks, func(c extensions.Check) bool { return c.ID == check.ID }) {
+					existing.Checks = append(existing.Checks, check)
+				}
+			}
+			merged[ruleID] = existing
+		}
+	}
+	if len(merged) == 0 {
+		return nil, fmt.Errorf("failed to find rules for component type %q", componentType)
+	}
+	return sortedRuleSets(merged), nil
+}
+
+// componentRuleSets returns the RuleSets defined by the component with the UUID. Validation
+// components return the checks they implement and other components return the checks of
+// the rule that apply to them.
+func (m *memoryIndex) componentRuleSets(componentUUID string) map[string]extensions.RuleSet {
+	defined := m.ruleSetsByComponentUUID[componentUUID]
+	_, implementsChecks := m.checksByValidationComponentUUID[componentUUID]
+	titles := set.New[string]()
+	titles.Add(m.titlesByComponentUUID[componentUUID])
+
+	ruleSets := make(map[string]extensions.RuleSet, len(defined))
+	for ruleID, ruleSet := range defined {
+		if !implementsChecks {
+			var checks []extensions.Check
+			for _, check := range m.nodes[ruleID].Checks {
+				if appliesToAny(check, titles) {
+					checks = append(checks, check)
+				}
+			}
+			ruleSet.Checks = checks
+		}
+		// Checks are copied so callers never modify the snapshot.
+		ruleSet.Checks = slices.Clone(ruleSet.Checks)
+		ruleSets[ruleID] = ruleSet
+	}
+	return ruleSets
+}
+
+// sortedRuleSets returns the RuleSets in rule ID order.
+func sortedRuleSets(ruleSets map[string]extensions.RuleSet) []extensions.RuleSet {
+	sorted := make([]extensions.RuleSet, 0, len(ruleSets))
+	for _, ruleID := range sortedKeys(ruleSets) {
+		sorted = append(sorted, ruleSets[ruleID])
+	}
+	return sorted
+}
+
+// componentLookup defines the rules and checks relevant to components with a title.
+type componentLookup struct {
+	// rules are the IDs of the rules associated with the components.
+	rules set.Set[string]
+	// validationChecks are the IDs of the checks implemented by the components,
+	// or nil if the components do not implement checks.
+	validationChecks set.Set[string]
+	// titles are the titles of the components for checks scoped to target components.
+	titles set.Set[string]
+}
+
+// findRules returns the RuleSets for a component lookup in rule ID order. The description
+// identifies the lookup in errors.
+func (m *memoryIndex) findRules(ctx context.Context, description string, lookup componentLookup) ([]extensions.RuleSet, error) {
+	if len(lookup.rules) == 0 {
+		return nil, fmt.Errorf("failed to find rules for %s", description)
 	}
 
 	var ruleSets []extensions.RuleSet
 	var errs []error
-	for ruleId := range ruleIds {
+	// Rule sets are returned in rule ID order.
+	for _, ruleId := range sortedKeys(lookup.rules) {
 		ruleSet, err := m.GetByRuleID(ctx, ruleId)
 		if err != nil {
 			errs = append(errs, err)
 		}
 
 		// Make sure we are only returning the relevant checks for this
-		// component.
-		if checkIds, ok := m.checksByValidationComponent[componentId]; ok {
+		// component. Validation components return the checks they implement
+		// and target components return the checks scoped to them.
+		if lookup.validationChecks != nil {
 			filteredChecks := make([]extensions.Check, 0, len(ruleSet.Checks))
 			for _, check := range ruleSet.Checks {
-				if checkIds.Has(check.ID) {
+				if lookup.validationChecks.Has(check.ID) {
+					filteredChecks = append(filteredChecks, check)
+				}
+			}
+			ruleSet.Checks = filteredChecks
+		} else if len(ruleSet.Checks) > 0 {
+			filteredChecks := make([]extensions.Check, 0, len(ruleSet.Checks))
+			for _, check := range ruleSet.Checks {
+				if appliesToAny(check, lookup.titles) {
 					filteredChecks = append(filteredChecks, check)
 				}
 			}
@@ -270,8 +635,33 @@ func (m *MemoryStore) FindByComponent(ctx context.Context, componentId string) (
 
 	if len(errs) > 0 {
 		joinedErr := errors.Join(errs...)
-		return ruleSets, fmt.Errorf("failed to find rules for component %q: %w", componentId, joinedErr)
+		return ruleSets, fmt.Errorf("failed to find rules for %s: %w", description, joinedErr)
 	}
-
 	return ruleSets, nil
 }
+
+// appliesToAny returns whether the check applies to a component with any of the titles.
+func appliesToAny(check extensions.Check, titles set.Set[string]) bool {
+	// Checks without a target component apply to all components.
+	if check.AppliesTo("") {
+		return true
+	}
+	for title := range titles {
+		if check.AppliesTo(title) {
+			return true
+		}
+	}
+	return false
+}
+
+// union returns a new set with the items of both sets.
+func union(a, b set.Set[string]) set.Set[string] {
+	result := set.New[string]()
+	for item := range a {
+		result.Add(item)
+	}
+	for item := range b {
+		result.Add(item)
+	}
+	return result
+}
@@ -7,7 +7,10 @@ package rules
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"slices"
+	"sync"
 	"testing"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
@@ -112,7 +115,7 @@ func TestMemoryStore_IndexAll(t *testing.T) {
 				require.EqualError(t, err, c.expError)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, c.wantNodes, testMemory.nodes)
+				require.Equal(t, c.wantNodes, testMemory.snapshot().nodes)
 			}
 		})
 	}
@@ -202,6 +205,467 @@ func TestMemoryStore_FindByComponent(t *testing.T) {
 	require.Contains(t, validator1RuleSet, expectedExampleRule, expectedKeyFileRule)
 }
 
+func TestMemoryStore_FindByComponent_TargetComponents(t *testing.T) {
+	testMemory := NewMemoryStore()
+	loadComponents(t, testMemory, "../testdata/component-definition-target-components.json")
+	testCtx := context.Background()
+
+	kubernetesCheck := extensions.Check{
+		ID:              "etcd_key_file_kubernetes",
+		Description:     "Check the --key-file argument on Kubernetes",
+		TargetComponent: "Kubernetes",
+	}
+	openShiftCheck := extensions.Check{
+		ID:              "etcd_key_file_openshift",
+		Description:     "Check the --key-file argument on OpenShift",
+		TargetComponent: "OpenShift",
+	}
+	auditLogRule := extensions.RuleSet{
+		Rule: extensions.Rule{
+			ID:          "audit_log_enabled",
+			Description: "Ensure that audit logging is enabled",
+		},
+		Checks: []extensions.Check{
+			{
+				ID:              "audit_log_enabled_kubernetes",
+				Description:     "Check that audit logging is enabled on Kubernetes",
+				TargetComponent: "Kubernetes",
+			},
+		},
+	}
+	keyFileRule := func(checks ...extensions.Check) extensions.RuleSet {
+		return extensions.RuleSet{
+			Rule: extensions.Rule{
+				ID:          "etcd_key_file",
+				Description: "Ensure that the --key-file argument is set as appropriate",
+			},
+			Checks: checks,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		componentID string
+		wantRules   []extensions.RuleSet
+	}{
+		{
+			name:        "Valid/TargetComponentWithScopedRule",
+			componentID: "Kubernetes",
+			wantRules:   []extensions.RuleSet{auditLogRule, keyFileRule(kubernetesCheck)},
+		},
+		{
+			name:        "Valid/TargetComponent",
+			componentID: "OpenShift",
+			wantRules:   []extensions.RuleSet{keyFileRule(openShiftCheck)},
+		},
+		{
+			name:        "Valid/ValidationComponent",
+			componentID: "Validator",
+			wantRules:   []extensions.RuleSet{auditLogRule, keyFileRule(kubernetesCheck, openShiftCheck)},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			gotRules, err := testMemory.FindByComponent(testCtx, c.componentID)
+			require.NoError(t, err)
+			require.Equal(t, c.wantRules, gotRules)
+		})
+	}
+}
+
+func TestMemoryStore_FindByComponentUUIDAndType(t *testing.T) {
+	ruleProps := func(ruleID string) *[]oscalTypes.Property {
+		return &[]oscalTypes.Property{
+			{Name: extensions.RuleIdProp, Value: ruleID, Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+		}
+	}
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll([]components.Component{
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "vendor-a", Title: "Kubernetes", Type: string(components.Service), Props: ruleProps("vendor_a_rule"),
+		}),
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "vendor-b", Title: "Kubernetes", Type: string(components.Software), Props: ruleProps("vendor_b_rule"),
+		}),
+	}))
+	testCtx := context.Background()
+
+	tests := []struct {
+		name        string
+		findFunc    func() ([]extensions.RuleSet, error)
+		wantRuleIDs []string
+		expError    string
+	}{
+		{
+			name:        "Valid/ByUUID",
+			findFunc:    func() ([]extensions.RuleSet, error) { return testMemory.FindByComponentUUID(testCtx, "vendor-a") },
+			wantRuleIDs: []string{"vendor_a_rule"},
+		},
+		{
+			name: "Valid/ByType",
+			findFunc: func() ([]extensions.RuleSet, error) {
+				return testMemory.FindByComponentType(testCtx, components.Software)
+			},
+			wantRuleIDs: []string{"vendor_b_rule"},
+		},
+		{
+			name:        "Valid/ByTitleMergesComponents",
+			findFunc:    func() ([]extensions.RuleSet, error) { return testMemory.FindByComponentTitle(testCtx, "Kubernetes") },
+			wantRuleIDs: []string{"vendor_a_rule", "vendor_b_rule"},
+		},
+		{
+			name:     "Invalid/UnknownUUID",
+			findFunc: func() ([]extensions.RuleSet, error) { return testMemory.FindByComponentUUID(testCtx, "vendor-c") },
+			expError: "failed to find rules for component \"vendor-c\"",
+		},
+		{
+			name: "Invalid/UnknownType",
+			findFunc: func() ([]extensions.RuleSet, error) {
+				return testMemory.FindByComponentType(testCtx, components.Validation)
+			},
+			expError: "failed to find rules for component type \"validation\"",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			gotRules, err := c.findFunc()
+			if c.expError != "" {
+				require.EqualError(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			var gotRuleIDs []string
+			for _, ruleSet := range gotRules {
+				gotRuleIDs = append(gotRuleIDs, ruleSet.Rule.ID)
+			}
+			require.Equal(t, c.wantRuleIDs, gotRuleIDs)
+		})
+	}
+}
+
+func TestMemoryStore_FindByComponentUUID_TargetComponents(t *testing.T) {
+	testMemory := NewMemoryStore()
+	loadComponents(t, testMemory, "../testdata/component-definition-target-components.json")
+	testCtx := context.Background()
+
+	// OpenShift
+	ruleSets, err := testMemory.FindByComponentUUID(testCtx, "7b4d1e9f-2c6a-4f3b-8d5e-6a1c9f2b7e02")
+	require.NoError(t, err)
+	require.Len(t, ruleSets, 1)
+	require.Equal(t, []string{"etcd_key_file_openshift"}, checkIDs(ruleSets[0]))
+
+	// Validator
+	ruleSets, err = testMemory.FindByComponentType(testCtx, components.Validation)
+	require.NoError(t, err)
+	require.Len(t, ruleSets, 2)
+	require.Equal(t, []string{"etcd_key_file_kubernetes", "etcd_key_file_openshift"}, checkIDs(ruleSets[1]))
+
+	// Kubernetes and OpenShift
+	ruleSets, err = testMemory.FindByComponentType(testCtx, components.Service)
+	require.NoError(t, err)
+	require.Len(t, ruleSets, 2)
+	require.Equal(t, []string{"audit_log_enabled_kubernetes"}, checkIDs(ruleSets[0]))
+	require.Equal(t, []string{"etcd_key_file_kubernetes", "etcd_key_file_openshift"}, checkIDs(ruleSets[1]))
+}
+
+func TestMemoryStore_FindByComponentUUID_SharedRuleID(t *testing.T) {
+	ruleProp := func(name, value, remarks string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: remarks}
+	}
+	// Two vendor definitions reuse the title and rule ID with different rule information.
+	vendorA := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+		UUID: "vendor-a", Title: "Kubernetes", Type: string(components.Service), Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+			ruleProp(extensions.RuleDescriptionProp, "Vendor A key file", "rule_set_0"),
+			ruleProp(extensions.ParameterIdProp, "key_file", "rule_set_0"),
+			ruleProp(extensions.ParameterDefaultProp, "/etc/a.key", "rule_set_0"),
+		},
+	})
+	vendorB := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+		UUID: "vendor-b", Title: "Kubernetes", Type: string(components.Service), Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+			ruleProp(extensions.RuleDescriptionProp, "Vendor B key file", "rule_set_0"),
+			ruleProp(extensions.ParameterIdProp, "key_file", "rule_set_0"),
+			ruleProp(extensions.ParameterDefaultProp, "/etc/b.key", "rule_set_0"),
+		},
+	})
+	validator := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+		UUID: "validator", Title: "Validator", Type: string(components.Validation), Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+			ruleProp(extensions.CheckIdProp, "etcd_key_file_check", "rule_set_0"),
+			// A rule scoped to the component title is not defined by either component.
+			ruleProp(extensions.RuleIdProp, "audit_log_enabled", "rule_set_1"),
+			ruleProp(extensions.CheckIdProp, "audit_log_enabled_check", "rule_set_1"),
+			ruleProp(extensions.TargetComponentProp, "Kubernetes", "rule_set_1"),
+		},
+	})
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll([]components.Component{vendorA, vendorB, validator}))
+	testCtx := context.Background()
+
+	for _, c := range []struct {
+		componentUUID   string
+		wantDescription string
+		wantDefault     string
+	}{
+		{componentUUID: "vendor-a", wantDescription: "Vendor A key file", wantDefault: "/etc/a.key"},
+		{componentUUID: "vendor-b", wantDescription: "Vendor B key file", wantDefault: "/etc/b.key"},
+	} {
+		ruleSets, err := testMemory.FindByComponentUUID(testCtx, c.componentUUID)
+		require.NoError(t, err)
+		require.Len(t, ruleSets, 1)
+		require.Equal(t, c.wantDescription, ruleSets[0].Rule.Description)
+		require.Equal(t, c.wantDefault, ruleSets[0].Rule.Parameters[0].Value)
+		require.Equal(t, []string{"etcd_key_file_check"}, checkIDs(ruleSets[0]))
+	}
+
+	ruleSets, err := testMemory.FindByComponentUUID(testCtx, "validator")
+	require.NoError(t, err)
+	require.Len(t, ruleSets, 2)
+	require.Empty(t, ruleSets[1].Rule.Description)
+
+	// The title lookup includes rules scoped to the title.
+	ruleSets, err = testMemory.FindByComponentTitle(testCtx, "Kubernetes")
+	require.NoError(t, err)
+	require.Len(t, ruleSets, 2)
+}
+
+func TestMemoryStore_ParameterDefaults(t *testing.T) {
+	ruleProp := func(name, value string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"}
+	}
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll([]components.Component{
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "kubernetes", Title: "Kubernetes", Type: string(components.Service), Props: &[]oscalTypes.Property{
+				ruleProp(extensions.RuleIdProp, "etcd_cipher_suites"),
+				ruleProp("Parameter_Id_1", "banner"),
+				ruleProp("Parameter_Value_Default_1", "Authorized use only, all activity is logged"),
+				ruleProp("Parameter_Id_2", "cipher_suites"),
+				ruleProp("Parameter_Value_Default_2", "TLS_AES_128_GCM_SHA256, TLS_AES_256_GCM_SHA384"),
+				ruleProp(extensions.ParameterTypeProp+"_2", string(extensions.ParameterTypeList)),
+			},
+		}),
+	}))
+
+	ruleSet, err := testMemory.GetByRuleID(context.Background(), "etcd_cipher_suites")
+	require.NoError(t, err)
+	require.Equal(t, []extensions.Parameter{
+		// Defaults of parameters that are not lists are kept as is.
+		{ID: "banner", Value: "Authorized use only, all activity is logged"},
+		{
+			ID:     "cipher_suites",
+			Value:  "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384",
+			Values: []string{"TLS_AES_128_GCM_SHA256", "TLS_AES_256_GCM_SHA384"},
+			Type:   extensions.ParameterTypeList,
+		},
+	}, ruleSet.Rule.Parameters)
+}
+
+func TestMemoryStore_UnindexAndReindex(t *testing.T) {
+	ruleProp := func(name, value, remarks string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: remarks}
+	}
+	service := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+		UUID: "service", Title: "Kubernetes", Type: string(components.Service), Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_cert_file", "rule_set_0"),
+			ruleProp(extensions.RuleDescriptionProp, "Ensure the cert file is set", "rule_set_0"),
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_1"),
+		},
+	})
+	validatorProps := func(certCheckDescription string) *[]oscalTypes.Property {
+		return &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_cert_file", "rule_set_0"),
+			ruleProp(extensions.CheckIdProp, "etcd_cert_file_check", "rule_set_0"),
+			ruleProp(extensions.CheckDescriptionProp, certCheckDescription, "rule_set_0"),
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_1"),
+			ruleProp(extensions.CheckIdProp, "etcd_key_file_check", "rule_set_1"),
+		}
+	}
+	validator := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+		UUID: "validator", Title: "Validator", Type: string(components.Validation), Props: validatorProps("Check the cert file"),
+	})
+	testCtx := context.Background()
+
+	t.Run("Valid/UnindexValidationComponent", func(t *testing.T) {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{service, validator}))
+		require.NoError(t, testMemory.Unindex("validator"))
+
+		_, err := testMemory.GetByCheckID(testCtx, "etcd_cert_file_check")
+		require.ErrorIs(t, err, ErrRuleNotFound)
+		ruleSet, err := testMemory.GetByRuleID(testCtx, "etcd_cert_file")
+		require.NoError(t, err)
+		require.Equal(t, "Ensure the cert file is set", ruleSet.Rule.Description)
+		require.Empty(t, ruleSet.Checks)
+		_, err = testMemory.FindByComponentUUID(testCtx, "validator")
+		require.EqualError(t, err, "failed to find rules for component \"validator\"")
+		_, err = testMemory.FindByComponentType(testCtx, components.Validation)
+		require.Error(t, err)
+	})
+
+	t.Run("Valid/UnindexRemovesUnreferencedRules", func(t *testing.T) {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{service, validator}))
+		require.NoError(t, testMemory.Unindex("service"))
+
+		// The validation component still references the rules.
+		ruleSet, err := testMemory.GetByCheckID(testCtx, "etcd_cert_file_check")
+		require.NoError(t, err)
+		require.Empty(t, ruleSet.Rule.Description)
+		_, err = testMemory.FindByComponentTitle(testCtx, "Kubernetes")
+		require.Error(t, err)
+
+		require.NoError(t, testMemory.Unindex("validator"))
+		_, err = testMemory.GetByRuleID(testCtx, "etcd_cert_file")
+		require.ErrorIs(t, err, ErrRuleNotFound)
+		_, err = testMemory.GetByRuleID(testCtx, "etcd_key_file")
+		require.ErrorIs(t, err, ErrRuleNotFound)
+	})
+
+	t.Run("Valid/ReindexReplacesRules", func(t *testing.T) {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{service, validator}))
+
+		updated := components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "validator", Title: "Validator", Type: string(components.Validation), Props: &[]oscalTypes.Property{
+				ruleProp(extensions.RuleIdProp, "etcd_cert_file", "rule_set_0"),
+				ruleProp(extensions.CheckIdProp, "etcd_cert_file_check", "rule_set_0"),
+				ruleProp(extensions.CheckDescriptionProp, "Check the updated cert file", "rule_set_0"),
+			},
+		})
+		require.NoError(t, testMemory.Reindex([]components.Component{updated}))
+
+		ruleSet, err := testMemory.GetByCheckID(testCtx, "etcd_cert_file_check")
+		require.NoError(t, err)
+		require.Equal(t, []extensions.Check{{ID: "etcd_cert_file_check", Description: "Check the updated cert file"}}, ruleSet.Checks)
+		_, err = testMemory.GetByCheckID(testCtx, "etcd_key_file_check")
+		require.ErrorIs(t, err, ErrRuleNotFound)
+
+		ruleSets, err := testMemory.FindByComponentUUID(testCtx, "validator")
+		require.NoError(t, err)
+		require.Len(t, ruleSets, 1)
+		ruleSets, err = testMemory.FindByComponentUUID(testCtx, "service")
+		require.NoError(t, err)
+		require.Len(t, ruleSets, 2)
+		require.Empty(t, ruleSets[1].Checks)
+	})
+
+	t.Run("Valid/ReindexNewComponent", func(t *testing.T) {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{service}))
+		require.NoError(t, testMemory.Reindex([]components.Component{validator}))
+
+		ruleSet, err := testMemory.GetByCheckID(testCtx, "etcd_cert_file_check")
+		require.NoError(t, err)
+		require.Equal(t, "Ensure the cert file is set", ruleSet.Rule.Description)
+	})
+
+	t.Run("Invalid/UnindexUnknownComponent", func(t *testing.T) {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{service, validator}))
+		err := testMemory.Unindex("validator", "unknown")
+		require.ErrorIs(t, err, ErrComponentNotIndexed)
+
+		// Nothing is removed when any component is unknown.
+		_, err = testMemory.FindByComponentUUID(testCtx, "validator")
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid/ReindexNoComponents", func(t *testing.T) {
+		err := NewMemoryStore().Reindex(nil)
+		require.ErrorIs(t, err, ErrComponentsNotFound)
+	})
+}
+
+func TestMemoryStore_Concurrency(t *testing.T) {
+	ruleProp := func(name, value, remarks string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: remarks}
+	}
+	validatorVersion := func(checkIDs ...string) components.Component {
+		var props []oscalTypes.Property
+		for i, checkID := range checkIDs {
+			remarks := fmt.Sprintf("rule_set_%d", i)
+			props = append(props,
+				ruleProp(extensions.RuleIdProp, "etcd_key_file", remarks),
+				ruleProp(extensions.CheckIdProp, checkID, remarks),
+			)
+		}
+		return components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "validator", Title: "Validator", Type: string(components.Validation), Props: &props,
+		})
+	}
+	versions := []components.Component{
+		validatorVersion("check_a"),
+		validatorVersion("check_a", "check_b"),
+	}
+
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll(versions[:1]))
+	testCtx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			if err := testMemory.Reindex(versions[i%2 : i%2+1]); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				ruleSets, err := testMemory.FindByComponentUUID(testCtx, "validator")
+				if err != nil {
+					errs <- err
+					return
+				}
+				// Readers see either version of the component, never a mix.
+				got := checkIDs(ruleSets[0])
+				if !slices.Equal(got, []string{"check_a"}) && !slices.Equal(got, []string{"check_a", "check_b"}) {
+					errs <- fmt.Errorf("inconsistent checks %v", got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestMemoryStore_Snapshot(t *testing.T) {
+	testMemory := prepMemoryStore(t)
+	testCtx := context.Background()
+
+	snapshot := testMemory.Snapshot()
+	require.NoError(t, testMemory.Unindex("701c70f1-482b-42b0-a419-9870158cd9e2"))
+
+	_, err := testMemory.GetByCheckID(testCtx, "etcd_key_file")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+
+	// The snapshot keeps the rule information from before the change.
+	ruleSet, err := snapshot.GetByCheckID(testCtx, "etcd_key_file")
+	require.NoError(t, err)
+	require.Equal(t, expectedKeyFileRule, ruleSet)
+}
+
+func checkIDs(ruleSet extensions.RuleSet) []string {
+	var ids []string
+	for _, check := range ruleSet.Checks {
+		ids = append(ids, check.ID)
+	}
+	return ids
+}
+
 func prepMemoryStore(t *testing.T) *MemoryStore {
 	testDataPath := "../testdata/component-definition-test.json"
 	testMemory := NewMemoryStore()
@@ -223,3 +687,45 @@ func loadComponents(t *testing.T, store *MemoryStore, testDataPath string) {
 	err = store.IndexAll(comps)
 	require.NoError(t, err)
 }
+
+func TestMemoryStore_StableOrder(t *testing.T) {
+	// Enough parameters for two digit suffixes to check the suffix order.
+	props := []oscalTypes.Property{
+		{Name: extensions.RuleIdProp, Value: "rule_b", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_1"},
+		{Name: extensions.RuleIdProp, Value: "rule_a", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0"},
+		{Name: extensions.RuleIdProp, Value: "rule_c", Ns: extensions.TrestleNameSpace, Remarks: "rule_set_2"},
+	}
+	var wantParameters []string
+	for i := 1; i <= 11; i++ {
+		id := fmt.Sprintf("parameter_%d", i)
+		wantParameters = append(wantParameters, id)
+		props = append(props, oscalTypes.Property{
+			Name: fmt.Sprintf("%s_%d", extensions.ParameterIdProp, i), Value: id, Ns: extensions.TrestleNameSpace, Remarks: "rule_set_0",
+		})
+	}
+	component := oscalTypes.DefinedComponent{UUID: "service", Title: "Service", Type: "service", Props: &props}
+
+	var first []extensions.RuleSet
+	for i := 0; i < 20; i++ {
+		testMemory := NewMemoryStore()
+		require.NoError(t, testMemory.IndexAll([]components.Component{components.NewDefinedComponentAdapter(component)}))
+		ruleSets, err := testMemory.FindByComponent(context.Background(), "Service")
+		require.NoError(t, err)
+		if first == nil {
+			first = ruleSets
+			continue
+		}
+		require.Equal(t, first, ruleSets)
+	}
+
+	var ruleIDs []string
+	for _, ruleSet := range first {
+		ruleIDs = append(ruleIDs, ruleSet.Rule.ID)
+	}
+	require.Equal(t, []string{"rule_a", "rule_b", "rule_c"}, ruleIDs)
+	var parameterIDs []string
+	for _, parameter := range first[0].Rule.Parameters {
+		parameterIDs = append(parameterIDs, parameter.ID)
+	}
+	require.Equal(t, wantParameters, parameterIDs)
+}
@@ -6,6 +6,7 @@
 package rules
 
 import (
+	"sort"
 	"strings"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
@@ -43,3 +44,13 @@ func getProp(name string, props set.Set[oscalTypes.Property]) (oscalTypes.Proper
 	}
 	return oscalTypes.Property{}, false
 }
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
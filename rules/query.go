@@ -0,0 +1,190 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+// ErrInvalidQuery defines an error returned when query options are invalid.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// QueryResult is a page of RuleSets matching a query.
+type QueryResult struct {
+	// RuleSets are the matching RuleSets on the page in rule ID order.
+	RuleSets []extensions.RuleSet
+	// Total is the number of matching RuleSets on all pages.
+	Total int
+	// NextOffset is the offset of the next page or zero if this is the last page.
+	NextOffset int
+}
+
+type queryOpts struct {
+	parameterID                      string
+	withoutChecks                    bool
+	checksWithoutValidationComponent bool
+	descriptionPattern               string
+	minComponents                    int
+	offset                           int
+	limit                            int
+}
+
+// QueryOption defines an option to filter or paginate queries on a Store.
+// All filters must match for a RuleSet to be returned.
+type QueryOption func(opts *queryOpts)
+
+// WithParameter defines a QueryOption to return rules with the given parameter ID.
+func WithParameter(parameterID string) QueryOption {
+	return func(opts *queryOpts) {
+		opts.parameterID = parameterID
+	}
+}
+
+// WithoutChecks defines a QueryOption to return rules with no checks.
+func WithoutChecks() QueryOption {
+	return func(opts *queryOpts) {
+		opts.withoutChecks = true
+	}
+}
+
+// WithChecksWithoutValidationComponent defines a QueryOption to return rules with checks
+// that are not implemented by any validation component. Only these checks are returned
+// on the RuleSets.
+func WithChecksWithoutValidationComponent() QueryOption {
+	return func(opts *queryOpts) {
+		opts.checksWithoutValidationComponent = true
+	}
+}
+
+// WithDescriptionPattern defines a QueryOption to return rules with a description
+// matching the regular expression.
+func WithDescriptionPattern(pattern string) QueryOption {
+	return func(opts *queryOpts) {
+		opts.descriptionPattern = pattern
+	}
+}
+
+// WithSharedByComponents defines a QueryOption to return rules that are
+// associated with at least the given number of components. Validation components
+// are not counted.
+func WithSharedByComponents(count int) QueryOption {
+	return func(opts *queryOpts) {
+		opts.minComponents = count
+	}
+}
+
+// WithPage defines a QueryOption to return at most limit RuleSets starting at the offset.
+// A limit of zero returns all RuleSets after the offset.
+func WithPage(offset, limit int) QueryOption {
+	return func(opts *queryOpts) {
+		opts.offset = offset
+		opts.limit = limit
+	}
+}
+
+func (m *MemoryStore) Query(ctx context.Context, opts ...QueryOption) (QueryResult, error) {
+	return m.snapshot().Query(ctx, opts...)
+}
+
+func (m *memoryIndex) Query(_ context.Context, opts ...QueryOption) (QueryResult, error) {
+	var options queryOpts
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.offset < 0 || options.limit < 0 {
+		return QueryResult{}, fmt.Errorf("%w: negative offset or limit", ErrInvalidQuery)
+	}
+	if options.minComponents < 0 {
+		return QueryResult{}, fmt.Errorf("%w: negative component count", ErrInvalidQuery)
+	}
+	var descriptionRegex *regexp.Regexp
+	if options.descriptionPattern != "" {
+		var err error
+		descriptionRegex, err = regexp.Compile(options.descriptionPattern)
+		if err != nil {
+			return QueryResult{}, fmt.Errorf("%w: description pattern: %w", ErrInvalidQuery, err)
+		}
+	}
+
+	var componentCounts map[string]int
+	if options.minComponents > 0 {
+		componentCounts = make(map[string]int)
+		validationComponents := m.componentsByType[components.Validation]
+		for componentUUID, ruleIDs := range m.rulesByComponentUUID {
+			if validationComponents.Has(componentUUID) {
+				continue
+			}
+			for ruleID := range ruleIDs {
+				componentCounts[ruleID]++
+			}
+		}
+	}
+
+	var matches []extensions.RuleSet
+	for _, ruleID := range sortedKeys(m.nodes) {
+		ruleSet := m.nodes[ruleID]
+		if options.parameterID != "" && !slices.ContainsFunc(ruleSet.Rule.Parameters, func(parameter extensions.Parameter) bool {
+			return parameter.ID == options.parameterID
+		}) {
+			continue
+		}
+		if options.withoutChecks && len(ruleSet.Checks) > 0 {
+			continue
+		}
+		if descriptionRegex != nil && !descriptionRegex.MatchString(ruleSet.Rule.Description) {
+			continue
+		}
+		if componentCounts != nil && componentCounts[ruleID] < options.minComponents {
+			continue
+		}
+		if options.checksWithoutValidationComponent {
+			ruleSet.Checks = m.checksWithoutValidationComponent(ruleSet.Checks)
+			if len(ruleSet.Checks) == 0 {
+				continue
+			}
+		}
+		matches = append(matches, ruleSet)
+	}
+
+	result := QueryResult{Total: len(matches)}
+	if options.offset >= len(matches) {
+		return result, nil
+	}
+	end := len(matches)
+	if options.limit > 0 && options.offset+options.limit < end {
+		end = options.offset + options.limit
+		result.NextOffset = end
+	}
+	result.RuleSets = matches[options.offset:end]
+	return result, nil
+}
+
+// checksWithoutValidationComponent returns the checks that are not declared by any
+// component of the validation type.
+func (m *memoryIndex) checksWithoutValidationComponent(checks []extensions.Check) []extensions.Check {
+	var filtered []extensions.Check
+	for _, check := range checks {
+		implemented := false
+		for componentUUID := range m.componentsByType[components.Validation] {
+			if m.checksByValidationComponentUUID[componentUUID].Has(check.ID) {
+				implemented = true
+				break
+			}
+		}
+		if !implemented {
+			filtered = append(filtered, check)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,158 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package rules
+
+import (
+	"context"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+func TestMemoryStore_Query(t *testing.T) {
+	ruleProp := func(name, value, remarks string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: remarks}
+	}
+	testMemory := NewMemoryStore()
+	require.NoError(t, testMemory.IndexAll([]components.Component{
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "kubernetes", Title: "Kubernetes", Type: string(components.Service), Props: &[]oscalTypes.Property{
+				ruleProp(extensions.RuleIdProp, "etcd_encryption", "rule_set_0"),
+				ruleProp(extensions.RuleDescriptionProp, "Ensure etcd encryption is enabled", "rule_set_0"),
+				ruleProp(extensions.ParameterIdProp, "encryption_provider", "rule_set_0"),
+				ruleProp(extensions.RuleIdProp, "audit_log", "rule_set_1"),
+				ruleProp(extensions.RuleDescriptionProp, "Ensure audit logging is enabled", "rule_set_1"),
+			},
+		}),
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "openshift", Title: "OpenShift", Type: string(components.Service), Props: &[]oscalTypes.Property{
+				ruleProp(extensions.RuleIdProp, "etcd_encryption", "rule_set_0"),
+				ruleProp(extensions.RuleIdProp, "etcd_tls", "rule_set_1"),
+				ruleProp(extensions.RuleDescriptionProp, "Ensure etcd uses TLS", "rule_set_1"),
+				ruleProp(extensions.CheckIdProp, "etcd_tls_check", "rule_set_1"),
+			},
+		}),
+		components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{
+			UUID: "validator", Title: "Validator", Type: string(components.Validation), Props: &[]oscalTypes.Property{
+				ruleProp(extensions.RuleIdProp, "etcd_encryption", "rule_set_0"),
+				ruleProp(extensions.CheckIdProp, "etcd_encryption_check", "rule_set_0"),
+				ruleProp(extensions.RuleIdProp, "etcd_tls", "rule_set_1"),
+				ruleProp(extensions.CheckIdProp, "etcd_tls_scan", "rule_set_1"),
+			},
+		}),
+	}))
+
+	tests := []struct {
+		name           string
+		opts           []QueryOption
+		wantRuleIDs    []string
+		wantTotal      int
+		wantNextOffset int
+		expError       error
+	}{
+		{
+			name:        "Valid/NoFilters",
+			wantRuleIDs: []string{"audit_log", "etcd_encryption", "etcd_tls"},
+			wantTotal:   3,
+		},
+		{
+			name:        "Valid/WithParameter",
+			opts:        []QueryOption{WithParameter("encryption_provider")},
+			wantRuleIDs: []string{"etcd_encryption"},
+			wantTotal:   1,
+		},
+		{
+			name:        "Valid/WithoutChecks",
+			opts:        []QueryOption{WithoutChecks()},
+			wantRuleIDs: []string{"audit_log"},
+			wantTotal:   1,
+		},
+		{
+			name:        "Valid/WithChecksWithoutValidationComponent",
+			opts:        []QueryOption{WithChecksWithoutValidationComponent()},
+			wantRuleIDs: []string{"etcd_tls"},
+			wantTotal:   1,
+		},
+		{
+			name:        "Valid/WithDescriptionPattern",
+			opts:        []QueryOption{WithDescriptionPattern("^Ensure etcd")},
+			wantRuleIDs: []string{"etcd_encryption", "etcd_tls"},
+			wantTotal:   2,
+		},
+		{
+			name:        "Valid/WithSharedByComponents",
+			opts:        []QueryOption{WithSharedByComponents(2)},
+			wantRuleIDs: []string{"etcd_encryption"},
+			wantTotal:   1,
+		},
+		{
+			// Validation components implementing checks are not counted.
+			name:      "Valid/WithSharedByComponentsExcludesValidationComponents",
+			opts:      []QueryOption{WithSharedByComponents(3)},
+			wantTotal: 0,
+		},
+		{
+			name:        "Valid/CombinedFilters",
+			opts:        []QueryOption{WithDescriptionPattern("etcd"), WithSharedByComponents(2)},
+			wantRuleIDs: []string{"etcd_encryption"},
+			wantTotal:   1,
+		},
+		{
+			name:           "Valid/FirstPage",
+			opts:           []QueryOption{WithPage(0, 2)},
+			wantRuleIDs:    []string{"audit_log", "etcd_encryption"},
+			wantTotal:      3,
+			wantNextOffset: 2,
+		},
+		{
+			name:        "Valid/LastPage",
+			opts:        []QueryOption{WithPage(2, 2)},
+			wantRuleIDs: []string{"etcd_tls"},
+			wantTotal:   3,
+		},
+		{
+			name:      "Valid/OffsetAfterLastPage",
+			opts:      []QueryOption{WithPage(5, 2)},
+			wantTotal: 3,
+		},
+		{
+			name:     "Invalid/NegativeOffset",
+			opts:     []QueryOption{WithPage(-1, 2)},
+			expError: ErrInvalidQuery,
+		},
+		{
+			name:     "Invalid/DescriptionPattern",
+			opts:     []QueryOption{WithDescriptionPattern("(etcd")},
+			expError: ErrInvalidQuery,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := testMemory.Query(context.Background(), c.opts...)
+			if c.expError != nil {
+				require.ErrorIs(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			var gotRuleIDs []string
+			for _, ruleSet := range result.RuleSets {
+				gotRuleIDs = append(gotRuleIDs, ruleSet.Rule.ID)
+			}
+			require.Equal(t, c.wantRuleIDs, gotRuleIDs)
+			require.Equal(t, c.wantTotal, result.Total)
+			require.Equal(t, c.wantNextOffset, result.NextOffset)
+		})
+	}
+
+	// Only the checks without a validation component are returned.
+	result, err := testMemory.Query(context.Background(), WithChecksWithoutValidationComponent())
+	require.NoError(t, err)
+	require.Equal(t, []string{"etcd_tls_check"}, checkIDs(result.RuleSets[0]))
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
 )
 
 // Store provides methods for filtering and searching RuleSets generated from OSCAL rule/check extensions.
@@ -18,8 +19,22 @@ type Store interface {
 	// GetByCheckID returns the RuleSet associated with the given check ID.
 	GetByCheckID(ctx context.Context, checkID string) (extensions.RuleSet, error)
 	// FindByComponent returns RuleSets associated with the component ID.
+	// It is an alias for FindByComponentTitle.
 	//
 	// For validation components, only relevant checks are returned.
-	// For non-validation or "target" components, all information is returned.
+	// For non-validation or "target" components, all information is returned except checks
+	// scoped to other target components.
 	FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error)
+	// FindByComponentTitle returns RuleSets associated with the component title. Components
+	// with the same title, e.g. from different component definitions, share their RuleSets.
+	FindByComponentTitle(ctx context.Context, title string) ([]extensions.RuleSet, error)
+	// FindByComponentUUID returns the RuleSets defined by the component with the UUID. The rule
+	// information comes from that component only, and rules scoped to the component title by
+	// validation component checks are not included.
+	FindByComponentUUID(ctx context.Context, componentUUID string) ([]extensions.RuleSet, error)
+	// FindByComponentType returns RuleSets associated with all components of the given type.
+	FindByComponentType(ctx context.Context, componentType components.ComponentType) ([]extensions.RuleSet, error)
+	// Query returns the RuleSets matching all filter options in rule ID order, paginated
+	// with WithPage.
+	Query(ctx context.Context, opts ...QueryOption) (QueryResult, error)
 }
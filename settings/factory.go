@@ -6,6 +6,8 @@
 package settings
 
 import (
+	"slices"
+
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
@@ -14,7 +16,19 @@ import (
 )
 
 // NewSettings returns a new Settings instance with given rules and associated rule parameters.
+// Each parameter has a single selected value. Use NewMultiValuedSettings for parameters with
+// multiple selected values.
 func NewSettings(rules map[string]struct{}, parameters map[string]string) Settings {
+	selectedParameters := make(map[string][]string, len(parameters))
+	for name, value := range parameters {
+		selectedParameters[name] = []string{value}
+	}
+	return NewMultiValuedSettings(rules, selectedParameters)
+}
+
+// NewMultiValuedSettings returns a new Settings instance with given rules and associated rule parameters.
+// Each parameter has one or more selected values.
+func NewMultiValuedSettings(rules map[string]struct{}, parameters map[string][]string) Settings {
 	return Settings{
 		selectedParameters: parameters,
 		mappedRules:        rules,
@@ -26,7 +40,7 @@ func NewSettings(rules map[string]struct{}, parameters map[string]string) Settin
 func NewImplementationSettings(controlImplementation components.Implementation) *ImplementationSettings {
 	implementation := &ImplementationSettings{
 		implementedReqSettings: make(map[string]Settings),
-		settings:               NewSettings(set.New[string](), make(map[string]string)),
+		settings:               NewMultiValuedSettings(set.New[string](), make(map[string][]string)),
 		controlsByRules:        make(map[string]set.Set[string]),
 		controlsById:           make(map[string]oscalTypes.AssessedControlsSelectControlById),
 	}
@@ -45,9 +59,11 @@ func NewImplementationSettings(controlImplementation components.Implementation)
 // Activity -> Rule
 // Title -> Rule ID
 // Parameter -> Activity Property
+//
+// Multi-valued parameters are read from multiple properties with the same name.
 func NewAssessmentActivitiesSettings(assessmentActivities []oscalTypes.Activity) Settings {
 	rules := set.New[string]()
-	parameters := make(map[string]string)
+	parameters := make(map[string][]string)
 	for _, activity := range assessmentActivities {
 
 		// Activities based on rules are expected to have at
@@ -63,7 +79,7 @@ func NewAssessmentActivitiesSettings(assessmentActivities []oscalTypes.Activity)
 
 		paramProps := extensions.FindAllProps(*activity.Props, extensions.WithClass(extensions.TestParameterClass))
 		for _, param := range paramProps {
-			parameters[param.Name] = param.Value
+			parameters[param.Name] = append(parameters[param.Name], param.Value)
 		}
 
 		rules.Add(activity.Title)
@@ -103,7 +119,7 @@ func newRequirementForImplementation(implementedReq components.Requirement, impl
 // settingsFromImplementedRequirement returns Settings populated with data from an
 // OSCAL Implemented Requirement.
 func settingsFromImplementedRequirement(implementedReq components.Requirement) Settings {
-	requirement := NewSettings(set.New[string](), make(map[string]string))
+	requirement := NewMultiValuedSettings(set.New[string](), make(map[string][]string))
 
 	mappedRulesProps := extensions.FindAllProps(implementedReq.Props(), extensions.WithName(extensions.RuleIdProp))
 	for _, mappedRule := range mappedRulesProps {
@@ -126,13 +142,11 @@ func settingsFromImplementedRequirement(implementedReq components.Requirement) S
 }
 
 // setParameters updates the paramMap with the input list of SetParameters.
-func setParameters(parameters []oscalTypes.SetParameter, paramMap map[string]string) {
+func setParameters(parameters []oscalTypes.SetParameter, paramMap map[string][]string) {
 	for _, prm := range parameters {
-		// Parameter values set for trestle Rule selection
-		// should only map to a single value.
-		if len(prm.Values) != 1 {
+		if len(prm.Values) == 0 {
 			continue
 		}
-		paramMap[prm.ParamId] = prm.Values[0]
+		paramMap[prm.ParamId] = slices.Clone(prm.Values)
 	}
 }
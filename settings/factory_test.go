@@ -16,6 +16,19 @@ import (
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 )
 
+func TestNewSettings(t *testing.T) {
+	rules := set.New[string]()
+	rules.Add("rule-1")
+
+	settings := NewSettings(rules, map[string]string{"param-1": "a,b"})
+	require.True(t, settings.ContainsRule("rule-1"))
+	require.Equal(t, map[string][]string{"param-1": {"a,b"}}, settings.selectedParameters)
+
+	settings = NewMultiValuedSettings(rules, map[string][]string{"param-1": {"a", "b"}})
+	require.True(t, settings.ContainsRule("rule-1"))
+	require.Equal(t, map[string][]string{"param-1": {"a", "b"}}, settings.selectedParameters)
+}
+
 func TestSettingsFromImplementedRequirements(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -43,7 +56,7 @@ func TestSettingsFromImplementedRequirements(t *testing.T) {
 					"rule-1": struct{}{},
 					"rule-2": struct{}{},
 				},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 		{
@@ -75,8 +88,8 @@ func TestSettingsFromImplementedRequirements(t *testing.T) {
 					"rule-1": struct{}{},
 					"rule-2": struct{}{},
 				},
-				selectedParameters: map[string]string{
-					"param-1": "value",
+				selectedParameters: map[string][]string{
+					"param-1": {"value"},
 				},
 			},
 		},
@@ -85,11 +98,11 @@ func TestSettingsFromImplementedRequirements(t *testing.T) {
 			inputRequirement: oscalTypes.ImplementedRequirementControlImplementation{},
 			wantSettings: Settings{
 				mappedRules:        map[string]struct{}{},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 		{
-			name: "Invalid/MultipleParametersValues",
+			name: "Valid/MultipleParametersValues",
 			inputRequirement: oscalTypes.ImplementedRequirementControlImplementation{
 				SetParameters: &[]oscalTypes.SetParameter{
 					{
@@ -101,9 +114,25 @@ func TestSettingsFromImplementedRequirements(t *testing.T) {
 					},
 				},
 			},
+			wantSettings: Settings{
+				mappedRules: set.Set[string]{},
+				selectedParameters: map[string][]string{
+					"param-1": {"value-1", "value-2"},
+				},
+			},
+		},
+		{
+			name: "Invalid/NoParameterValues",
+			inputRequirement: oscalTypes.ImplementedRequirementControlImplementation{
+				SetParameters: &[]oscalTypes.SetParameter{
+					{
+						ParamId: "param-1",
+					},
+				},
+			},
 			wantSettings: Settings{
 				mappedRules:        set.Set[string]{},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 	}
@@ -150,7 +179,7 @@ func TestNewAssessmentActivitiesSettings(t *testing.T) {
 					"rule-1": struct{}{},
 					"rule-2": struct{}{},
 				},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 		{
@@ -186,8 +215,38 @@ func TestNewAssessmentActivitiesSettings(t *testing.T) {
 					"rule-1": struct{}{},
 					"rule-2": struct{}{},
 				},
-				selectedParameters: map[string]string{
-					"param-1": "value",
+				selectedParameters: map[string][]string{
+					"param-1": {"value"},
+				},
+			},
+		},
+		{
+			name: "Valid/MultiValuedParametersFound",
+			inputActivities: []oscalTypes.Activity{
+				{
+					Title: "rule-1",
+					Props: &[]oscalTypes.Property{
+						{
+							Name:  "allowed-registries",
+							Ns:    extensions.TrestleNameSpace,
+							Value: "quay.io",
+							Class: extensions.TestParameterClass,
+						},
+						{
+							Name:  "allowed-registries",
+							Ns:    extensions.TrestleNameSpace,
+							Value: "ghcr.io",
+							Class: extensions.TestParameterClass,
+						},
+					},
+				},
+			},
+			wantSettings: Settings{
+				mappedRules: set.Set[string]{
+					"rule-1": struct{}{},
+				},
+				selectedParameters: map[string][]string{
+					"allowed-registries": {"quay.io", "ghcr.io"},
 				},
 			},
 		},
@@ -199,7 +258,7 @@ func TestNewAssessmentActivitiesSettings(t *testing.T) {
 			},
 			wantSettings: Settings{
 				mappedRules:        map[string]struct{}{},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 	}
@@ -91,7 +91,7 @@ func TestByFramework(t *testing.T) {
 				"etcd_cert_file": struct{}{},
 				"etcd_key_file":  struct{}{},
 			},
-			selectedParameters: map[string]string{},
+			selectedParameters: map[string][]string{},
 		},
 		implementedReqSettings: map[string]Settings{
 			"CIS-2.1": {
@@ -99,7 +99,7 @@ func TestByFramework(t *testing.T) {
 					"etcd_cert_file": struct{}{},
 					"etcd_key_file":  struct{}{},
 				},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 		},
 		controlsByRules: map[string]set.Set[string]{
@@ -67,8 +67,8 @@ func TestMerge(t *testing.T) {
 						"my-test-rule":   struct{}{},
 						"my-test-rule-2": struct{}{},
 					},
-					selectedParameters: map[string]string{
-						"my-test-param": "test-value",
+					selectedParameters: map[string][]string{
+						"my-test-param": {"test-value"},
 					},
 				},
 				implementedReqSettings: map[string]Settings{
@@ -77,14 +77,14 @@ func TestMerge(t *testing.T) {
 							"etcd_cert_file": struct{}{},
 							"etcd_key_file":  struct{}{},
 						},
-						selectedParameters: map[string]string{},
+						selectedParameters: map[string][]string{},
 					},
 					"ex-1": {
 						mappedRules: set.Set[string]{
 							"my-test-rule":   struct{}{},
 							"my-test-rule-2": struct{}{},
 						},
-						selectedParameters: map[string]string{},
+						selectedParameters: map[string][]string{},
 					},
 				},
 				controlsByRules: map[string]set.Set[string]{
@@ -140,7 +140,7 @@ func TestMerge(t *testing.T) {
 						"etcd_key_file":  struct{}{},
 						"my-test-rule":   struct{}{},
 					},
-					selectedParameters: map[string]string{},
+					selectedParameters: map[string][]string{},
 				},
 				implementedReqSettings: map[string]Settings{
 					"CIS-2.1": {
@@ -149,8 +149,8 @@ func TestMerge(t *testing.T) {
 							"etcd_key_file":  struct{}{},
 							"my-test-rule":   struct{}{},
 						},
-						selectedParameters: map[string]string{
-							"my-test-param": "test-value",
+						selectedParameters: map[string][]string{
+							"my-test-param": {"test-value"},
 						},
 					},
 				},
@@ -194,7 +194,7 @@ func TestMerge(t *testing.T) {
 						"etcd_cert_file": struct{}{},
 						"etcd_key_file":  struct{}{},
 					},
-					selectedParameters: map[string]string{},
+					selectedParameters: map[string][]string{},
 				},
 				implementedReqSettings: map[string]Settings{
 					"CIS-2.1": {
@@ -202,13 +202,13 @@ func TestMerge(t *testing.T) {
 							"etcd_cert_file": struct{}{},
 							"etcd_key_file":  struct{}{},
 						},
-						selectedParameters: map[string]string{},
+						selectedParameters: map[string][]string{},
 					},
 					"ex-1": {
 						mappedRules: set.Set[string]{
 							"etcd_cert_file": struct{}{},
 						},
-						selectedParameters: map[string]string{},
+						selectedParameters: map[string][]string{},
 					},
 				},
 				controlsByRules: map[string]set.Set[string]{
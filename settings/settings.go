@@ -25,7 +25,7 @@ type Settings struct {
 	// mappedRules is a list of rule IDs that are mapped to this requirement.
 	mappedRules set.Set[string]
 	// selectedParameters is a map of parameter names and their selected values for this requirement.
-	selectedParameters map[string]string
+	selectedParameters map[string][]string
 }
 
 // ApplyParameterSettings returns the given rule set with update parameter values based on the implementation.
@@ -33,19 +33,32 @@ type Settings struct {
 // If the implementation does have parameter values or the rule set does not have a parameter, the original rule set
 // is returned.
 // The parameter value is not altered on the original rule set, it is copied and returned with the new rule set.
-func (i Settings) ApplyParameterSettings(set extensions.RuleSet) extensions.RuleSet {
+// Multi-valued parameters are applied with Parameter.SetValues.
+// An error wrapping extensions.ErrInvalidParameterValue for each invalid value is returned, along with the original
+// rule set, if a selected value is not one of the parameter alternatives or does not match the parameter type.
+func (i Settings) ApplyParameterSettings(set extensions.RuleSet) (extensions.RuleSet, error) {
 	if len(i.selectedParameters) > 0 && len(set.Rule.Parameters) > 0 {
 		sliceCopy := make([]extensions.Parameter, len(set.Rule.Parameters))
 		copy(sliceCopy, set.Rule.Parameters)
+		var errs []error
 		for idx := range sliceCopy {
-			selectedValue, ok := i.selectedParameters[sliceCopy[idx].ID]
-			if ok {
-				sliceCopy[idx].Value = selectedValue
+			selectedValues, ok := i.selectedParameters[sliceCopy[idx].ID]
+			if !ok {
+				continue
 			}
+			for _, selectedValue := range selectedValues {
+				if err := sliceCopy[idx].ValidateValue(selectedValue); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			sliceCopy[idx].SetValues(selectedValues...)
+		}
+		if len(errs) > 0 {
+			return set, fmt.Errorf("rule %q: %w", set.Rule.ID, errors.Join(errs...))
 		}
 		set.Rule.Parameters = sliceCopy
 	}
-	return set
+	return set, nil
 }
 
 // ContainsRule returns whether the given rule id is defined in the Settings.
@@ -57,18 +70,38 @@ func (i Settings) ContainsRule(ruleId string) bool {
 //
 // Only the rules that overlap between the component and the mapped rules in the implementation are returned.
 // Parameters will be applied as RuleSet selected parameter values.
+// For target components, rules scoped to the component by validation component checks are included and
+// only the checks targeting the component are returned.
+//
+// The component is identified by its title. Use ApplyToComponentUUID when titles are not unique.
 func ApplyToComponent(ctx context.Context, componentId string, store rules.Store, settings Settings) ([]extensions.RuleSet, error) {
-	var resolvedRules []extensions.RuleSet
 	componentRuleSets, err := store.FindByComponent(ctx, componentId)
 	if err != nil {
 		return []extensions.RuleSet{}, err
 	}
+	return applyToRuleSets(componentId, componentRuleSets, settings)
+}
+
+// ApplyToComponentUUID returns a list of RuleSets for the component with the given UUID with options
+// applied from the given Settings, in the same way as ApplyToComponent.
+func ApplyToComponentUUID(ctx context.Context, componentUUID string, store rules.Store, settings Settings) ([]extensions.RuleSet, error) {
+	componentRuleSets, err := store.FindByComponentUUID(ctx, componentUUID)
+	if err != nil {
+		return []extensions.RuleSet{}, err
+	}
+	return applyToRuleSets(componentUUID, componentRuleSets, settings)
+}
 
+func applyToRuleSets(componentId string, componentRuleSets []extensions.RuleSet, settings Settings) ([]extensions.RuleSet, error) {
+	var resolvedRules []extensions.RuleSet
 	for _, ruleSet := range componentRuleSets {
 		if !settings.ContainsRule(ruleSet.Rule.ID) {
 			continue
 		}
-		ruleSet = settings.ApplyParameterSettings(ruleSet)
+		ruleSet, err := settings.ApplyParameterSettings(ruleSet)
+		if err != nil {
+			return []extensions.RuleSet{}, fmt.Errorf("component %s: %w", componentId, err)
+		}
 		resolvedRules = append(resolvedRules, ruleSet)
 	}
 	if len(resolvedRules) == 0 {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
 	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
 	"github.com/oscal-compass/oscal-sdk-go/rules"
 )
 
@@ -35,7 +36,7 @@ func TestApplyToComponents(t *testing.T) {
 					"testRule1": struct{}{},
 					"testRule2": struct{}{},
 				},
-				selectedParameters: map[string]string{},
+				selectedParameters: map[string][]string{},
 			},
 			wantRules: []extensions.RuleSet{testSet2},
 		},
@@ -43,8 +44,8 @@ func TestApplyToComponents(t *testing.T) {
 			name:        "Valid/WithParameterOverrides",
 			componentID: "testComponent2",
 			settings: Settings{
-				selectedParameters: map[string]string{
-					"testParam1": "updatedValue",
+				selectedParameters: map[string][]string{
+					"testParam1": {"updatedValue"},
 				},
 				mappedRules: set.Set[string]{
 					"testRule1": struct{}{},
@@ -114,6 +115,102 @@ func TestApplyToComponents(t *testing.T) {
 	}
 }
 
+func TestSettings_ApplyParameterSettings(t *testing.T) {
+	ruleSet := extensions.RuleSet{
+		Rule: extensions.Rule{
+			ID: "testRule",
+			Parameters: []extensions.Parameter{
+				{
+					ID:           "testParam",
+					Value:        "10",
+					Alternatives: []string{"10", "20"},
+					Type:         extensions.ParameterTypeInteger,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		selected   map[string][]string
+		wantValue  string
+		wantValues []string
+		expError   string
+	}{
+		{
+			name:      "Valid/NoSelectedValue",
+			selected:  map[string][]string{},
+			wantValue: "10",
+		},
+		{
+			name:      "Valid/Alternative",
+			selected:  map[string][]string{"testParam": {"20"}},
+			wantValue: "20",
+		},
+		{
+			name:       "Valid/MultipleValues",
+			selected:   map[string][]string{"testParam": {"10", "20"}},
+			wantValue:  "10,20",
+			wantValues: []string{"10", "20"},
+		},
+		{
+			name:     "Invalid/MultipleValues",
+			selected: map[string][]string{"testParam": {"10", "30"}},
+			expError: `rule "testRule": invalid parameter value: parameter "testParam": "30" is not one of 10, 20`,
+		},
+		{
+			name:     "Invalid/AllInvalidValues",
+			selected: map[string][]string{"testParam": {"30", "2O"}},
+			expError: "rule \"testRule\": invalid parameter value: parameter \"testParam\": \"30\" is not one of 10, 20\n" +
+				"invalid parameter value: parameter \"testParam\": \"2O\" is not an integer",
+		},
+		{
+			name:     "Invalid/NotAnAlternative",
+			selected: map[string][]string{"testParam": {"30"}},
+			expError: `rule "testRule": invalid parameter value: parameter "testParam": "30" is not one of 10, 20`,
+		},
+		{
+			name:     "Invalid/Type",
+			selected: map[string][]string{"testParam": {"2O"}},
+			expError: `rule "testRule": invalid parameter value: parameter "testParam": "2O" is not an integer`,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			settings := Settings{selectedParameters: c.selected}
+			got, err := settings.ApplyParameterSettings(ruleSet)
+			if c.expError != "" {
+				require.EqualError(t, err, c.expError)
+				require.ErrorIs(t, err, extensions.ErrInvalidParameterValue)
+				require.Equal(t, ruleSet, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.wantValue, got.Rule.Parameters[0].Value)
+			require.Equal(t, c.wantValues, got.Rule.Parameters[0].Values)
+			// The original rule set is not altered.
+			require.Equal(t, "10", ruleSet.Rule.Parameters[0].Value)
+		})
+	}
+}
+
+func TestApplyToComponentUUID(t *testing.T) {
+	testCtx := context.Background()
+	store := newFakeStore()
+	settings := Settings{
+		mappedRules: set.Set[string]{
+			"testRule2": struct{}{},
+		},
+	}
+
+	gotRules, err := ApplyToComponentUUID(testCtx, "testComponentUUID1", store, settings)
+	require.NoError(t, err)
+	require.Equal(t, []extensions.RuleSet{testSet2}, gotRules)
+
+	_, err = ApplyToComponentUUID(testCtx, "testComponent1", store, settings)
+	require.EqualError(t, err, "invalid component uuid: testComponent1")
+}
+
 var (
 	testSet1 = extensions.RuleSet{
 		Rule: extensions.Rule{
@@ -198,6 +295,27 @@ func (f fakeStore) GetByCheckID(ctx context.Context, checkID string) (extensions
 	}
 }
 
+func (f fakeStore) FindByComponentTitle(ctx context.Context, title string) ([]extensions.RuleSet, error) {
+	return f.FindByComponent(ctx, title)
+}
+
+func (f fakeStore) FindByComponentUUID(ctx context.Context, componentUUID string) ([]extensions.RuleSet, error) {
+	switch componentUUID {
+	case "testComponentUUID1":
+		return f.FindByComponent(ctx, "testComponent1")
+	default:
+		return []extensions.RuleSet{}, fmt.Errorf("invalid component uuid: %s", componentUUID)
+	}
+}
+
+func (f fakeStore) FindByComponentType(_ context.Context, componentType components.ComponentType) ([]extensions.RuleSet, error) {
+	return []extensions.RuleSet{}, fmt.Errorf("invalid component type: %s", componentType)
+}
+
+func (f fakeStore) Query(_ context.Context, _ ...rules.QueryOption) (rules.QueryResult, error) {
+	return rules.QueryResult{}, nil
+}
+
 func (f fakeStore) FindByComponent(ctx context.Context, componentId string) ([]extensions.RuleSet, error) {
 	switch componentId {
 	case "testComponent1":
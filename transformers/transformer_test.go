@@ -12,10 +12,12 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
 	"github.com/oscal-compass/oscal-sdk-go/models"
 	"github.com/oscal-compass/oscal-sdk-go/validation"
 )
@@ -72,6 +74,33 @@ func TestComponentDefinitionsToAssessmentPlan(t *testing.T) {
 	require.NoError(t, validator.Validate(oscalModels))
 }
 
+func TestComponentDefinitionsToAssessmentPlan_DeterministicUUIDs(t *testing.T) {
+	file, err := os.Open(filepath.Join("../testdata", "component-definition-test.json"))
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	first, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", WithDeterministicUUIDs("seed"))
+	require.NoError(t, err)
+	second, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", WithDeterministicUUIDs("seed"))
+	require.NoError(t, err)
+
+	// Only the last-modified timestamp differs between the plans.
+	second.Metadata.LastModified = first.Metadata.LastModified
+	require.Equal(t, first, second)
+
+	random, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis")
+	require.NoError(t, err)
+	require.NotEqual(t, first.UUID, random.UUID)
+
+	seeded, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", WithDeterministicUUIDs("other-seed"))
+	require.NoError(t, err)
+	require.NotEqual(t, first.UUID, seeded.UUID)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentPlan: first}))
+}
+
 func TestSSPToAssessmentPlan(t *testing.T) {
 	testDataPath := filepath.Join("../testdata", "test-ssp.json")
 
@@ -126,3 +155,45 @@ func TestAssessmentPlanToAssessmentResults(t *testing.T) {
 	}
 	require.NoError(t, validator.Validate(oscalModels))
 }
+
+func TestAssessmentResultsToPOAM(t *testing.T) {
+	file, err := os.Open(filepath.Join("../testdata", "test-ap.json"))
+	require.NoError(t, err)
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	subject := oscalTypes.SubjectReference{SubjectUuid: "4e19131e-b361-4f0e-8262-02bf4456202e", Type: "component"}
+	require.NoError(t, extensions.SetCheckOutcome(&subject, extensions.CheckOutcome{Result: extensions.CheckResultFail}))
+	observation := oscalTypes.Observation{
+		UUID:        "0d1b7d68-4ac3-4f42-9d1b-ef3d1fd3a4b1",
+		Title:       "check-1",
+		Description: "check-1",
+		Methods:     []string{"TEST"},
+		Collected:   time.Now(),
+		Subjects:    &[]oscalTypes.SubjectReference{subject},
+	}
+	results, err := AssessmentPlanToAssessmentResults(*plan, "importPath", observation)
+	require.NoError(t, err)
+
+	poam, err := AssessmentResultsToPOAM(*results, "sspPath")
+	require.NoError(t, err)
+	require.Equal(t, "sspPath", poam.ImportSsp.Href)
+
+	// One item per failed control of rule-1.
+	require.Len(t, poam.PoamItems, 2)
+	require.Len(t, *poam.Findings, 2)
+	require.Len(t, *poam.Observations, 1)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: poam}))
+
+	// Results from a later scan update the tracked items.
+	results, err = AssessmentPlanToAssessmentResults(*plan, "importPath", observation)
+	require.NoError(t, err)
+	merged, err := AssessmentResultsToPOAM(*results, "sspPath", WithPOAM(poam))
+	require.NoError(t, err)
+	require.Len(t, merged.PoamItems, 2)
+	require.Len(t, *merged.PoamItems[0].RelatedFindings, 2)
+	require.Len(t, *merged.Findings, 4)
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: merged}))
+}
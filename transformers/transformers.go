@@ -9,17 +9,64 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
+	"github.com/oscal-compass/oscal-sdk-go/internal/identifiers"
 	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/internal/poams"
 	"github.com/oscal-compass/oscal-sdk-go/internal/results"
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 	"github.com/oscal-compass/oscal-sdk-go/settings"
 )
 
+type transformOpts struct {
+	deterministic bool
+	seed          string
+	poam          *oscalTypes.PlanOfActionAndMilestones
+}
+
+// TransformOption defines an option to tune the behavior of the
+// transformations to OSCAL Assessment Plans and POA&Ms.
+type TransformOption func(opts *transformOpts)
+
+// WithDeterministicUUIDs is a TransformOption that derives name-based (version 5) UUIDs
+// from stable input keys instead of generating random UUIDs, so transforming the same
+// inputs produces the same UUIDs. The seed distinguishes documents transformed from the
+// same inputs and may be empty. Only the UUIDs are deterministic; timestamps such as the
+// last-modified time of the document are still set to the current time, except for POA&Ms,
+// which take their timestamps from the Assessment Results.
+func WithDeterministicUUIDs(seed string) TransformOption {
+	return func(opts *transformOpts) {
+		opts.deterministic = true
+		opts.seed = seed
+	}
+}
+
+// WithPOAM is a TransformOption that merges the POA&M items from AssessmentResultsToPOAM into
+// an existing POA&M, so items that are already tracked are updated instead of duplicated.
+func WithPOAM(existing *oscalTypes.PlanOfActionAndMilestones) TransformOption {
+	return func(opts *transformOpts) {
+		opts.poam = existing
+	}
+}
+
+// generateOptions returns the options for plan generation, deriving deterministic UUIDs
+// from the seed if enabled.
+func (t transformOpts) generateOptions() (identifiers.Generator, []plans.GenerateOption) {
+	if !t.deterministic {
+		return identifiers.Generator{}, nil
+	}
+	return identifiers.NewDeterministic(t.seed), []plans.GenerateOption{plans.WithDeterministicUUIDs(t.seed)}
+}
+
 // ComponentDefinitionsToAssessmentPlan transforms the data from one or more OSCAL Component Definitions to a single OSCAL Assessment Plan.
-func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []oscalTypes.ComponentDefinition, framework string) (*oscalTypes.AssessmentPlan, error) {
+func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []oscalTypes.ComponentDefinition, framework string, opts ...TransformOption) (*oscalTypes.AssessmentPlan, error) {
+	var options transformOpts
+	for _, opt := range opts {
+		opt(&options)
+	}
+	ids, generateOptions := options.generateOptions()
+
 	// Collect and aggregate all component information for each component definition
 	var allComponents []components.Component
 	var allImplementations []oscalTypes.ControlImplementationSet
@@ -41,14 +88,14 @@ func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []osc
 	if err != nil || implementationSettings == nil {
 		return nil, fmt.Errorf("cannot transform definitions for framework %s: %w", framework, err)
 	}
-	assessmentPlan, err := plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings)
+	assessmentPlan, err := plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, generateOptions...)
 	if err != nil {
 		return nil, err
 	}
 
 	// Add control source resource to maintain traceability to original control set.
 	controlSource := oscalTypes.Resource{
-		UUID:        uuid.NewUUID(),
+		UUID:        ids.UUID("resource", frameworkSrc.Href),
 		Description: frameworkSrc.Description,
 		Title:       frameworkSrc.Title,
 		Rlinks: &[]oscalTypes.ResourceLink{
@@ -80,7 +127,13 @@ func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []osc
 }
 
 // SSPToAssessmentPlan transforms the data from a System Security Plan at a given import location to a single OSCAL Assessment Plan.
-func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan, sspImportPath string) (*oscalTypes.AssessmentPlan, error) {
+func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan, sspImportPath string, opts ...TransformOption) (*oscalTypes.AssessmentPlan, error) {
+	var options transformOpts
+	for _, opt := range opts {
+		opt(&options)
+	}
+	_, generateOptions := options.generateOptions()
+
 	var allComponents []components.Component
 	for _, sysComp := range ssp.SystemImplementation.Components {
 		componentAdapter := components.NewSystemComponentAdapter(sysComp)
@@ -98,7 +151,8 @@ func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan,
 		return nil, fmt.Errorf("cannot transform ssp at path %s", sspImportPath)
 	}
 
-	return plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, plans.WithImport(sspImportPath))
+	generateOptions = append(generateOptions, plans.WithImport(sspImportPath))
+	return plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, generateOptions...)
 }
 
 // AssessmentPlanToAssessmentResults transforms the data from an Assessment Plan at a given import location to OSCAL Assessment Results.
@@ -111,3 +165,22 @@ func AssessmentPlanToAssessmentResults(plan oscalTypes.AssessmentPlan, apImportP
 	}
 	return results.GenerateAssessmentResults(plan, options...)
 }
+
+// AssessmentResultsToPOAM transforms the not-satisfied findings and open risks from OSCAL Assessment Results to
+// items of an OSCAL Plan of Action and Milestones for the System Security Plan at a given import location.
+func AssessmentResultsToPOAM(assessmentResults oscalTypes.AssessmentResults, sspImportPath string, opts ...TransformOption) (*oscalTypes.PlanOfActionAndMilestones, error) {
+	var options transformOpts
+	for _, opt := range opts {
+		opt(&options)
+	}
+	generateOptions := []poams.GenerateOption{
+		poams.WithImport(sspImportPath),
+	}
+	if options.deterministic {
+		generateOptions = append(generateOptions, poams.WithDeterministicUUIDs(options.seed))
+	}
+	if options.poam != nil {
+		generateOptions = append(generateOptions, poams.WithPOAM(options.poam))
+	}
+	return poams.GeneratePOAM(assessmentResults, generateOptions...)
+}
@@ -0,0 +1,391 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+var _ Validator = (*ConstraintValidator)(nil)
+
+/*
+ConstraintValidator implements a validation.Validator and enforces
+OSCAL Metaschema constraints that cannot be expressed in the OSCAL JSON schema.
+*/
+type ConstraintValidator struct {
+	id          string
+	constraints []Constraint
+}
+
+// NewConstraintValidator returns a new ConstraintValidator with the default
+// OSCAL constraints.
+func NewConstraintValidator() *ConstraintValidator {
+	return NewConstraintValidatorWithConstraints(DefaultConstraints()...)
+}
+
+// NewConstraintValidatorWithConstraints returns a new ConstraintValidator that only
+// enforces the given constraints.
+func NewConstraintValidatorWithConstraints(constraints ...Constraint) *ConstraintValidator {
+	return &ConstraintValidator{
+		id:          "constraints",
+		constraints: constraints,
+	}
+}
+
+func (c *ConstraintValidator) Validate(modelData oscalTypes.OscalModels) error {
+	document, err := toDocument(modelData)
+	if err != nil {
+		return &ValidationError{Type: c.id, Model: "", Err: err}
+	}
+
+	modelType := modelTypeOf(document)
+	indexes := make(map[string]map[string]struct{})
+	for _, constraint := range c.constraints {
+		if index, ok := constraint.(Index); ok {
+			indexes[index.Name] = index.build(document)
+		}
+	}
+
+	var findings []Finding
+	for _, constraint := range c.constraints {
+		findings = append(findings, constraint.evaluate(document, indexes)...)
+	}
+	if len(findings) > 0 {
+		return newFindingsError(c.id, modelType, findings)
+	}
+	return nil
+}
+
+// Constraint defines a single OSCAL Metaschema constraint evaluated against
+// the JSON representation of an OSCAL document.
+//
+// Constraint targets are paths of "/" separated JSON keys relative to the document root.
+// A "*" segment selects every item of an array or object, a "**" segment selects the current
+// item and all of its descendants, and a `[key=value]` suffix filters selected objects
+// by the string value of a key.
+type Constraint interface {
+	// ConstraintID returns the identifier of the constraint.
+	ConstraintID() string
+	evaluate(document interface{}, indexes map[string]map[string]struct{}) []Finding
+}
+
+// AllowedValues requires the string values at the target to be one of the given values.
+type AllowedValues struct {
+	ID     string
+	Target string
+	Values []string
+}
+
+func (a AllowedValues) ConstraintID() string { return a.ID }
+
+func (a AllowedValues) evaluate(document interface{}, _ map[string]map[string]struct{}) []Finding {
+	var violations []Finding
+	for _, n := range selectNodes(document, a.Target) {
+		value, ok := n.value.(string)
+		if !ok || slices.Contains(a.Values, value) {
+			continue
+		}
+		violations = append(violations, Finding{
+			RuleID:   a.ID,
+			Location: n.pointer,
+			Value:    value,
+			Message:  fmt.Sprintf("value %q is not one of [%s]", value, strings.Join(a.Values, ", ")),
+		})
+	}
+	return violations
+}
+
+// Matches requires the string values at the target to match the given regular expression.
+type Matches struct {
+	ID      string
+	Target  string
+	Pattern *regexp.Regexp
+}
+
+func (m Matches) ConstraintID() string { return m.ID }
+
+func (m Matches) evaluate(document interface{}, _ map[string]map[string]struct{}) []Finding {
+	var violations []Finding
+	for _, n := range selectNodes(document, m.Target) {
+		value, ok := n.value.(string)
+		if !ok || m.Pattern.MatchString(value) {
+			continue
+		}
+		violations = append(violations, Finding{
+			RuleID:   m.ID,
+			Location: n.pointer,
+			Value:    value,
+			Message:  fmt.Sprintf("value %q does not match %q", value, m.Pattern.String()),
+		})
+	}
+	return violations
+}
+
+// IsUnique requires the Key of each item selected by Target to be unique within
+// each item selected by Context.
+type IsUnique struct {
+	ID      string
+	Context string
+	Target  string
+	Key     string
+}
+
+func (u IsUnique) ConstraintID() string { return u.ID }
+
+func (u IsUnique) evaluate(document interface{}, _ map[string]map[string]struct{}) []Finding {
+	var violations []Finding
+	for _, ctx := range selectNodes(document, u.Context) {
+		seen := make(map[string]struct{})
+		for _, item := range selectFrom(ctx, u.Target) {
+			for _, key := range selectFrom(item, u.Key) {
+				value, ok := key.value.(string)
+				if !ok {
+					continue
+				}
+				if _, dup := seen[value]; dup {
+					violations = append(violations, Finding{
+						RuleID:   u.ID,
+						Location: key.pointer,
+						Value:    value,
+						Message:  fmt.Sprintf("value %q is not unique", value),
+					})
+					continue
+				}
+				seen[value] = struct{}{}
+			}
+		}
+	}
+	return violations
+}
+
+// HasCardinality requires the number of items selected by Target within each item selected
+// by Context to be between MinOccurs and MaxOccurs. A negative MaxOccurs is unbounded.
+type HasCardinality struct {
+	ID        string
+	Context   string
+	Target    string
+	MinOccurs int
+	MaxOccurs int
+}
+
+func (h HasCardinality) ConstraintID() string { return h.ID }
+
+func (h HasCardinality) evaluate(document interface{}, _ map[string]map[string]struct{}) []Finding {
+	var violations []Finding
+	for _, ctx := range selectNodes(document, h.Context) {
+		count := len(selectFrom(ctx, h.Target))
+		if count >= h.MinOccurs && (h.MaxOccurs < 0 || count <= h.MaxOccurs) {
+			continue
+		}
+		violations = append(violations, Finding{
+			RuleID:   h.ID,
+			Location: ctx.pointer,
+			Value:    strconv.Itoa(count),
+			Message:  fmt.Sprintf("found %d occurrences of %q, expected between %d and %s", count, h.Target, h.MinOccurs, maxOccursString(h.MaxOccurs)),
+		})
+	}
+	return violations
+}
+
+func maxOccursString(maxOccurs int) string {
+	if maxOccurs < 0 {
+		return "unbounded"
+	}
+	return strconv.Itoa(maxOccurs)
+}
+
+// Index defines a named set of keys from the items selected by Target. The index
+// is referenced by IndexHasKey constraints.
+type Index struct {
+	Name   string
+	Target string
+	Key    string
+}
+
+func (i Index) ConstraintID() string { return i.Name }
+
+func (i Index) evaluate(_ interface{}, _ map[string]map[string]struct{}) []Finding {
+	return nil
+}
+
+func (i Index) build(document interface{}) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, item := range selectNodes(document, i.Target) {
+		for _, key := range selectFrom(item, i.Key) {
+			if value, ok := key.value.(string); ok {
+				keys[value] = struct{}{}
+			}
+		}
+	}
+	return keys
+}
+
+// IndexHasKey requires the string values at the Target to be keys in the named Index.
+// When Fragment is set, only values that are URI fragments (e.g. "#uuid") are checked
+// against the index.
+type IndexHasKey struct {
+	ID       string
+	Index    string
+	Target   string
+	Fragment bool
+}
+
+func (k IndexHasKey) ConstraintID() string { return k.ID }
+
+func (k IndexHasKey) evaluate(document interface{}, indexes map[string]map[string]struct{}) []Finding {
+	index := indexes[k.Index]
+	var violations []Finding
+	for _, n := range selectNodes(document, k.Target) {
+		value, ok := n.value.(string)
+		if !ok {
+			continue
+		}
+		key := value
+		if k.Fragment {
+			if !strings.HasPrefix(value, "#") {
+				continue
+			}
+			key = strings.TrimPrefix(value, "#")
+		}
+		if _, found := index[key]; found {
+			continue
+		}
+		violations = append(violations, Finding{
+			RuleID:   k.ID,
+			Location: n.pointer,
+			Value:    value,
+			Message:  fmt.Sprintf("value %q is not a key in index %q", value, k.Index),
+		})
+	}
+	return violations
+}
+
+// toDocument returns the generic JSON representation of the OSCAL models.
+func toDocument(modelData oscalTypes.OscalModels) (interface{}, error) {
+	data, err := json.Marshal(modelData)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// modelTypeOf returns the root key of the OSCAL document.
+func modelTypeOf(document interface{}) string {
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for key := range root {
+		return key
+	}
+	return ""
+}
+
+// node is a value in the JSON document located by a JSON pointer.
+type node struct {
+	pointer string
+	value   interface{}
+}
+
+// selectNodes returns all nodes matching the target path from the document root.
+func selectNodes(document interface{}, target string) []node {
+	return selectFrom(node{value: document}, target)
+}
+
+// selectFrom returns all nodes matching the target path relative to the given node.
+func selectFrom(start node, target string) []node {
+	if target == "" {
+		return []node{start}
+	}
+	current := []node{start}
+	for _, segment := range strings.Split(target, "/") {
+		name, filterKey, filterValue := parseSegment(segment)
+		var next []node
+		for _, n := range current {
+			var candidates []node
+			switch name {
+			case "**":
+				candidates = descendants(n)
+			case "*":
+				candidates = children(n)
+			default:
+				if obj, ok := n.value.(map[string]interface{}); ok {
+					if value, found := obj[name]; found {
+						candidates = []node{{pointer: n.pointer + "/" + escapePointer(name), value: value}}
+					}
+				}
+			}
+			for _, candidate := range candidates {
+				if filterKey != "" {
+					obj, ok := candidate.value.(map[string]interface{})
+					if !ok || obj[filterKey] != filterValue {
+						continue
+					}
+				}
+				next = append(next, candidate)
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// parseSegment splits a path segment into the name and an optional `[key=value]` filter.
+func parseSegment(segment string) (name, filterKey, filterValue string) {
+	open := strings.Index(segment, "[")
+	if open < 0 || !strings.HasSuffix(segment, "]") {
+		return segment, "", ""
+	}
+	name = segment[:open]
+	filter := segment[open+1 : len(segment)-1]
+	filterKey, filterValue, _ = strings.Cut(filter, "=")
+	return name, filterKey, filterValue
+}
+
+func children(n node) []node {
+	var result []node
+	switch v := n.value.(type) {
+	case []interface{}:
+		for i, item := range v {
+			result = append(result, node{pointer: n.pointer + "/" + strconv.Itoa(i), value: item})
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result = append(result, node{pointer: n.pointer + "/" + escapePointer(key), value: v[key]})
+		}
+	}
+	return result
+}
+
+func descendants(n node) []node {
+	result := []node{n}
+	for _, child := range children(n) {
+		result = append(result, descendants(child)...)
+	}
+	return result
+}
+
+// escapePointer escapes a JSON pointer reference token as defined in RFC 6901.
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
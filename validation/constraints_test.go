@@ -0,0 +1,183 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		modelData oscalTypes.OscalModels
+		wantErrs  []string
+	}{
+		{
+			name: "Valid/ComponentDefinition",
+			modelData: oscalTypes.OscalModels{
+				ComponentDefinition: &oscalTypes.ComponentDefinition{
+					UUID: "c14d8812-7098-4a9b-8f89-cba41b6ff0d8",
+					Metadata: oscalTypes.Metadata{
+						Roles: &[]oscalTypes.Role{{ID: "maintainer", Title: "Maintainer"}},
+						ResponsibleParties: &[]oscalTypes.ResponsibleParty{
+							{RoleId: "maintainer", PartyUuids: []string{"party-1"}},
+						},
+						Parties: &[]oscalTypes.Party{{UUID: "party-1", Type: "organization"}},
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid/AllowedValues",
+			modelData: oscalTypes.OscalModels{
+				AssessmentPlan: &oscalTypes.AssessmentPlan{
+					Tasks: &[]oscalTypes.Task{{UUID: "task-1", Type: "not-a-type"}},
+				},
+			},
+			wantErrs: []string{`task-type: /assessment-plan/tasks/0/type: value "not-a-type" is not one of [milestone, action]`},
+		},
+		{
+			name: "Invalid/IndexHasKey",
+			modelData: oscalTypes.OscalModels{
+				ComponentDefinition: &oscalTypes.ComponentDefinition{
+					Metadata: oscalTypes.Metadata{
+						ResponsibleParties: &[]oscalTypes.ResponsibleParty{
+							{RoleId: "maintainer", PartyUuids: []string{"party-1"}},
+						},
+					},
+				},
+			},
+			wantErrs: []string{
+				`metadata-responsible-party-role-id: /component-definition/metadata/responsible-parties/0/role-id: value "maintainer" is not a key in index "index-metadata-role-id"`,
+				`responsible-party-party-uuids: /component-definition/metadata/responsible-parties/0/party-uuids/0: value "party-1" is not a key in index "index-metadata-party-uuid"`,
+			},
+		},
+		{
+			name: "Invalid/IsUnique",
+			modelData: oscalTypes.OscalModels{
+				Catalog: &oscalTypes.Catalog{
+					Groups: &[]oscalTypes.Group{
+						{Controls: &[]oscalTypes.Control{{ID: "ac-1"}}},
+						{Controls: &[]oscalTypes.Control{{ID: "ac-1"}}},
+					},
+				},
+			},
+			wantErrs: []string{`unique-catalog-control-id: /catalog/groups/1/controls/0/id: value "ac-1" is not unique`},
+		},
+		{
+			name: "Invalid/HasCardinality",
+			modelData: oscalTypes.OscalModels{
+				SystemSecurityPlan: &oscalTypes.SystemSecurityPlan{
+					SystemImplementation: oscalTypes.SystemImplementation{
+						Components: []oscalTypes.SystemComponent{
+							{UUID: "comp-1", Type: "software", Status: oscalTypes.SystemComponentStatus{State: "operational"}},
+						},
+					},
+					SystemCharacteristics: oscalTypes.SystemCharacteristics{
+						Status: oscalTypes.Status{State: "operational"},
+					},
+				},
+			},
+			wantErrs: []string{`ssp-this-system-component: /system-security-plan/system-implementation: found 0 occurrences of "components/*[type=this-system]", expected between 1 and 1`},
+		},
+		{
+			name: "Invalid/Matches",
+			modelData: oscalTypes.OscalModels{
+				Profile: &oscalTypes.Profile{
+					BackMatter: &oscalTypes.BackMatter{
+						Resources: &[]oscalTypes.Resource{
+							{
+								UUID: "resource-1",
+								Rlinks: &[]oscalTypes.ResourceLink{
+									{Href: "catalog.json", Hashes: &[]oscalTypes.Hash{{Algorithm: "SHA-256", Value: "abc"}}},
+								},
+							},
+						},
+					},
+					Imports: []oscalTypes.Import{{Href: "#resource-2"}},
+				},
+			},
+			wantErrs: []string{
+				`hash-sha-256: /profile/back-matter/resources/0/rlinks/0/hashes/0/value: value "abc" does not match "^[0-9a-fA-F]{64}$"`,
+				`profile-import-resource: /profile/imports/0/href: value "#resource-2" is not a key in index "index-back-matter-resource"`,
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			validator := NewConstraintValidator()
+			require.Equal(t, "constraints", validator.id)
+			err := validator.Validate(c.modelData)
+			if len(c.wantErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, wantErr := range c.wantErrs {
+				require.ErrorContains(t, err, wantErr)
+			}
+		})
+	}
+}
+
+func TestConstraintValidator_TestData(t *testing.T) {
+	tests := []struct {
+		testDataPath string
+		wantErr      string
+	}{
+		{testDataPath: "../testdata/test-catalog.json"},
+		{testDataPath: "../testdata/test-profile.json"},
+		{testDataPath: "../testdata/test-ap.json"},
+		{testDataPath: "../testdata/component-definition-test.json"},
+		{
+			testDataPath: "../testdata/test-ssp.json",
+			wantErr:      `ssp-by-component-uuid: /system-security-plan/control-implementation/implemented-requirements/0/statements/0/by-components/0/component-uuid: value "a95533ab-9427-4abe-820f-0b571bacfe6d" is not a key in index "index-system-component-uuid"`,
+		},
+	}
+	validator := NewConstraintValidator()
+	for _, c := range tests {
+		t.Run(c.testDataPath, func(t *testing.T) {
+			data, err := os.ReadFile(c.testDataPath)
+			require.NoError(t, err)
+			var modelData oscalTypes.OscalModels
+			require.NoError(t, json.Unmarshal(data, &modelData))
+			err = validator.Validate(modelData)
+			if c.wantErr != "" {
+				require.EqualError(t, err, "constraints: "+c.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSelectNodes(t *testing.T) {
+	var document interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"a":{"b/c":[{"k":"x","v":"1"},{"k":"y","v":"2"}]}}`), &document))
+
+	nodes := selectNodes(document, "a/b/c/*[k=y]/v")
+	require.Empty(t, nodes)
+
+	nodes = selectNodes(document, "a/*/*[k=y]/v")
+	require.Len(t, nodes, 1)
+	require.Equal(t, "/a/b~1c/1/v", nodes[0].pointer)
+	require.Equal(t, "2", nodes[0].value)
+
+	nodes = selectNodes(document, "**/v")
+	require.Len(t, nodes, 2)
+
+	matches := Matches{ID: "test", Target: "**/v", Pattern: regexp.MustCompile(`^1$`)}
+	violations := matches.evaluate(document, nil)
+	require.Len(t, violations, 1)
+	require.Equal(t, "/a/b~1c/1/v", violations[0].Location)
+}
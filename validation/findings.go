@@ -0,0 +1,92 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Severity defines how severe a validation Finding is.
+type Severity string
+
+const (
+	// SeverityError marks a Finding that makes the document invalid.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a Finding that does not make the document invalid.
+	SeverityWarning Severity = "warning"
+)
+
+// Finding describes a single violation found by a Validator.
+type Finding struct {
+	// Validator is the type of validator that reported the finding.
+	Validator string
+	// Severity is the severity of the violation.
+	Severity Severity
+	// RuleID identifies the rule or constraint that was violated.
+	RuleID string
+	// Location is a JSON pointer to the violating value in the OSCAL document.
+	Location string
+	// Value is the offending value, if any.
+	Value string
+	// Message describes the violation.
+	Message string
+}
+
+func (f Finding) Error() string {
+	if f.Location == "" {
+		return fmt.Sprintf("%s: %s", f.RuleID, f.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", f.RuleID, f.Location, f.Message)
+}
+
+// newFindingsError returns a ValidationError for the given findings.
+func newFindingsError(validatorType, model string, findings []Finding) *ValidationError {
+	errs := make([]error, 0, len(findings))
+	for i := range findings {
+		findings[i].Validator = validatorType
+		if findings[i].Severity == "" {
+			findings[i].Severity = SeverityError
+		}
+		errs = append(errs, findings[i])
+	}
+	return &ValidationError{Type: validatorType, Model: model, Err: errors.Join(errs...), Findings: findings}
+}
+
+// FindingsFromError returns all findings from an error returned by a Validator, including
+// errors joined by ValidateAll. Errors that do not carry findings are returned as a
+// single Finding with the error message.
+func FindingsFromError(err error) []Finding {
+	if err == nil {
+		return nil
+	}
+
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) {
+		var findings []Finding
+		for _, e := range joined.Unwrap() {
+			findings = append(findings, FindingsFromError(e)...)
+		}
+		return findings
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		if len(validationErr.Findings) > 0 {
+			return validationErr.Findings
+		}
+		return []Finding{{
+			Validator: validationErr.Type,
+			Severity:  SeverityError,
+			Message:   validationErr.Err.Error(),
+		}}
+	}
+
+	return []Finding{{
+		Severity: SeverityError,
+		Message:  err.Error(),
+	}}
+}
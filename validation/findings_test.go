@@ -0,0 +1,72 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindingsFromError(t *testing.T) {
+	modelData := oscalTypes.OscalModels{
+		ComponentDefinition: &oscalTypes.ComponentDefinition{
+			UUID: "not-a-uuid",
+			Metadata: oscalTypes.Metadata{
+				OscalVersion: "1.1.3",
+				Version:      "0.1.0",
+				LastModified: time.Now(),
+			},
+			Components: &[]oscalTypes.DefinedComponent{
+				{UUID: "not-a-uuid", Title: "Component", Type: "software", Description: "Component"},
+			},
+		},
+	}
+
+	validateAll := ValidateAll(NewSchemaValidator(), UuidValidator{}, TestValidatorWithErr{})
+	err := validateAll(modelData)
+	require.Error(t, err)
+
+	findings := FindingsFromError(err)
+	require.Greater(t, len(findings), 2)
+
+	byValidator := make(map[string][]Finding)
+	for _, finding := range findings {
+		require.Equal(t, SeverityError, finding.Severity)
+		byValidator[finding.Validator] = append(byValidator[finding.Validator], finding)
+	}
+
+	require.Contains(t, byValidator, "schema")
+	var schemaLocations []string
+	for _, finding := range byValidator["schema"] {
+		require.Equal(t, "not-a-uuid", finding.Value)
+		require.Contains(t, finding.RuleID, "pattern")
+		schemaLocations = append(schemaLocations, finding.Location)
+	}
+	require.ElementsMatch(t, []string{"/component-definition/uuid", "/component-definition/components/0/uuid"}, schemaLocations)
+
+	require.Equal(t, []Finding{
+		{
+			Validator: "uuid",
+			Severity:  SeverityError,
+			RuleID:    "duplicate-uuid",
+			Location:  "/component-definition/components/0/uuid",
+			Value:     "not-a-uuid",
+			Message:   "value \"not-a-uuid\" is already defined at /component-definition/uuid",
+		},
+	}, byValidator["uuid"])
+
+	require.Equal(t, []Finding{{Severity: SeverityError, Message: "test error"}}, byValidator[""])
+}
+
+func TestFindingsFromError_ValidationErrorWithoutFindings(t *testing.T) {
+	err := &ValidationError{Type: "custom", Model: "catalog", Err: errors.New("custom error")}
+	require.Equal(t, []Finding{{Validator: "custom", Severity: SeverityError, Message: "custom error"}}, FindingsFromError(err))
+	require.Nil(t, FindingsFromError(nil))
+}
@@ -0,0 +1,112 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Below are the JUnit XML types used to export validation findings.
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// EncodeJUnit writes the Report as JUnit XML. Each validator is a test suite and each
+// finding is a test case. Error findings are failed test cases and warning findings are
+// passing test cases with the finding in the system output. Validators without findings
+// have a single passing test case.
+func EncodeJUnit(w io.Writer, report Report) error {
+	suites := junitTestSuites{
+		Name: report.DocumentURI,
+	}
+
+	for _, validatorType := range report.validatorTypes() {
+		suite := junitTestSuite{
+			Name: validatorType,
+		}
+		findings := report.findingsByValidator(validatorType)
+		if len(findings) == 0 {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      validatorType,
+				ClassName: report.DocumentURI,
+			})
+		}
+		for _, finding := range findings {
+			testCase := junitTestCaseFromFinding(report, finding)
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+		suite.Tests = len(suite.TestCases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.TestSuites = append(suites.TestSuites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTestCaseFromFinding(report Report, finding Finding) junitTestCase {
+	name := finding.RuleID
+	if finding.Location != "" {
+		name = fmt.Sprintf("%s %s", finding.RuleID, finding.Location)
+	}
+	text := finding.Message
+	if line, column, found := report.position(finding); found {
+		text = fmt.Sprintf("%s:%d:%d: %s", report.DocumentURI, line, column, finding.Message)
+	}
+	testCase := junitTestCase{
+		Name:      name,
+		ClassName: report.DocumentURI,
+	}
+	if finding.Severity == SeverityWarning {
+		testCase.SystemOut = fmt.Sprintf("%s: %s", finding.Severity, text)
+		return testCase
+	}
+	testCase.Failure = &junitFailure{
+		Message: finding.Message,
+		Type:    string(finding.Severity),
+		Text:    text,
+	}
+	return testCase
+}
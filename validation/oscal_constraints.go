@@ -0,0 +1,168 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import "regexp"
+
+// DefaultConstraints returns the OSCAL Metaschema constraints enforced by the
+// ConstraintValidator by default.
+//
+// Reference: https://pages.nist.gov/OSCAL-Reference/models/v1.1.3/
+func DefaultConstraints() []Constraint {
+	return []Constraint{
+		// Allowed values without allow-other
+		AllowedValues{
+			ID:     "observation-methods",
+			Target: "**/observations/*/methods/*",
+			Values: []string{"EXAMINE", "INTERVIEW", "TEST", "UNKNOWN"},
+		},
+		AllowedValues{
+			ID:     "task-type",
+			Target: "**/tasks/*/type",
+			Values: []string{"milestone", "action"},
+		},
+		AllowedValues{
+			ID:     "finding-target-type",
+			Target: "**/findings/*/target/type",
+			Values: []string{"statement-id", "objective-id"},
+		},
+		AllowedValues{
+			ID:     "finding-target-status",
+			Target: "**/findings/*/target/status/state",
+			Values: []string{"satisfied", "not-satisfied"},
+		},
+		AllowedValues{
+			ID:     "origin-actor-type",
+			Target: "**/origins/*/actors/*/type",
+			Values: []string{"tool", "assessment-platform", "party"},
+		},
+		AllowedValues{
+			ID:     "subject-reference-type",
+			Target: "**/include-subjects/*/type",
+			Values: []string{"component", "inventory-item", "location", "party", "user"},
+		},
+		AllowedValues{
+			ID:     "system-status-state",
+			Target: "system-security-plan/system-characteristics/status/state",
+			Values: []string{"operational", "under-development", "under-major-modification", "disposition", "other"},
+		},
+		AllowedValues{
+			ID:     "system-component-status-state",
+			Target: "**/system-implementation/components/*/status/state",
+			Values: []string{"under-development", "operational", "disposition", "other"},
+		},
+
+		// Matches
+		Matches{
+			ID:      "hash-sha-256",
+			Target:  "**/hashes/*[algorithm=SHA-256]/value",
+			Pattern: regexp.MustCompile(`^[0-9a-fA-F]{64}$`),
+		},
+		Matches{
+			ID:      "hash-sha-384",
+			Target:  "**/hashes/*[algorithm=SHA-384]/value",
+			Pattern: regexp.MustCompile(`^[0-9a-fA-F]{96}$`),
+		},
+		Matches{
+			ID:      "hash-sha-512",
+			Target:  "**/hashes/*[algorithm=SHA-512]/value",
+			Pattern: regexp.MustCompile(`^[0-9a-fA-F]{128}$`),
+		},
+
+		// Uniqueness
+		IsUnique{
+			ID:      "unique-metadata-role-id",
+			Context: "*/metadata",
+			Target:  "roles/*",
+			Key:     "id",
+		},
+		IsUnique{
+			ID:      "unique-metadata-party-uuid",
+			Context: "*/metadata",
+			Target:  "parties/*",
+			Key:     "uuid",
+		},
+		IsUnique{
+			ID:      "unique-responsible-party-role-id",
+			Context: "**/responsible-parties",
+			Target:  "*",
+			Key:     "role-id",
+		},
+		IsUnique{
+			ID:      "unique-catalog-control-id",
+			Context: "catalog",
+			Target:  "**/controls/*",
+			Key:     "id",
+		},
+		IsUnique{
+			ID:      "unique-set-parameter-param-id",
+			Context: "**/set-parameters",
+			Target:  "*",
+			Key:     "param-id",
+		},
+
+		// Cardinality
+		HasCardinality{
+			ID:        "ssp-this-system-component",
+			Context:   "system-security-plan/system-implementation",
+			Target:    "components/*[type=this-system]",
+			MinOccurs: 1,
+			MaxOccurs: 1,
+		},
+		HasCardinality{
+			ID:        "control-label",
+			Context:   "catalog/**/controls/*",
+			Target:    "props/*[name=label]",
+			MinOccurs: 0,
+			MaxOccurs: 1,
+		},
+
+		// Cross-references
+		Index{Name: "index-metadata-role-id", Target: "*/metadata/roles/*", Key: "id"},
+		IndexHasKey{
+			ID:     "metadata-responsible-party-role-id",
+			Index:  "index-metadata-role-id",
+			Target: "*/metadata/responsible-parties/*/role-id",
+		},
+		Index{Name: "index-metadata-party-uuid", Target: "*/metadata/parties/*", Key: "uuid"},
+		IndexHasKey{
+			ID:     "responsible-party-party-uuids",
+			Index:  "index-metadata-party-uuid",
+			Target: "**/responsible-parties/*/party-uuids/*",
+		},
+		Index{Name: "index-back-matter-resource", Target: "*/back-matter/resources/*", Key: "uuid"},
+		IndexHasKey{
+			ID:       "profile-import-resource",
+			Index:    "index-back-matter-resource",
+			Target:   "profile/imports/*/href",
+			Fragment: true,
+		},
+		Index{Name: "index-system-component-uuid", Target: "system-security-plan/system-implementation/components/*", Key: "uuid"},
+		IndexHasKey{
+			ID:     "ssp-by-component-uuid",
+			Index:  "index-system-component-uuid",
+			Target: "system-security-plan/control-implementation/**/by-components/*/component-uuid",
+		},
+		Index{Name: "index-activity-uuid", Target: "assessment-plan/local-definitions/activities/*", Key: "uuid"},
+		IndexHasKey{
+			ID:     "ap-associated-activity-uuid",
+			Index:  "index-activity-uuid",
+			Target: "assessment-plan/tasks/**/associated-activities/*/activity-uuid",
+		},
+		Index{Name: "index-observation-uuid", Target: "**/observations/*", Key: "uuid"},
+		IndexHasKey{
+			ID:     "related-observation-uuid",
+			Index:  "index-observation-uuid",
+			Target: "**/related-observations/*/observation-uuid",
+		},
+		Index{Name: "index-risk-uuid", Target: "**/risks/*", Key: "uuid"},
+		IndexHasKey{
+			ID:     "related-risk-uuid",
+			Index:  "index-risk-uuid",
+			Target: "**/related-risks/*/risk-uuid",
+		},
+	}
+}
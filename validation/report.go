@@ -0,0 +1,181 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+)
+
+const (
+	toolName           = "oscal-sdk-go"
+	toolInformationURI = "https://github.com/oscal-compass/oscal-sdk-go"
+	// defaultValidatorType is used for findings from errors that are not reported
+	// by a known validator.
+	defaultValidatorType = "validation"
+)
+
+// Report contains the validation results for a single OSCAL document to be
+// exported to other formats.
+type Report struct {
+	// DocumentURI is the location of the validated document.
+	DocumentURI string
+	// Content is the optional raw JSON content of the document. When set,
+	// finding locations are mapped to line and column numbers.
+	Content []byte
+	// Validators lists the types of the validators that were run. Validators
+	// without findings are reported as passing.
+	Validators []string
+	// Findings are the violations reported by the validators.
+	Findings []Finding
+}
+
+// NewReport returns a Report for a document from the error returned by a Validator.
+func NewReport(documentURI string, content []byte, validators []string, err error) Report {
+	return Report{
+		DocumentURI: documentURI,
+		Content:     content,
+		Validators:  validators,
+		Findings:    FindingsFromError(err),
+	}
+}
+
+// validatorTypes returns the validators that were run and all validators that reported
+// findings in a stable order.
+func (r Report) validatorTypes() []string {
+	types := slices.Clone(r.Validators)
+	for _, finding := range r.Findings {
+		validatorType := findingValidator(finding)
+		if !slices.Contains(types, validatorType) {
+			types = append(types, validatorType)
+		}
+	}
+	return types
+}
+
+// findingsByValidator returns the findings reported by the given validator type.
+func (r Report) findingsByValidator(validatorType string) []Finding {
+	var findings []Finding
+	for _, finding := range r.Findings {
+		if findingValidator(finding) == validatorType {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+func findingValidator(finding Finding) string {
+	if finding.Validator == "" {
+		return defaultValidatorType
+	}
+	return finding.Validator
+}
+
+// position returns the 1-based line and column of the finding location in the report
+// content and whether it was found. Findings without a location have no position.
+func (r Report) position(finding Finding) (line, column int, found bool) {
+	if finding.Location == "" {
+		return 0, 0, false
+	}
+	return locate(r.Content, finding.Location)
+}
+
+// locate returns the 1-based line and column of the value at the JSON pointer in the
+// JSON content and whether the value was found.
+func locate(content []byte, pointer string) (line, column int, found bool) {
+	type frame struct {
+		object  bool
+		key     string
+		index   int
+		wantKey bool
+	}
+
+	currentPointer := func(stack []frame) string {
+		var buf bytes.Buffer
+		for _, f := range stack {
+			buf.WriteString("/")
+			if f.object {
+				buf.WriteString(escapePointer(f.key))
+			} else {
+				buf.WriteString(strconv.Itoa(f.index))
+			}
+		}
+		return buf.String()
+	}
+
+	advance := func(stack []frame) {
+		if len(stack) == 0 {
+			return
+		}
+		top := &stack[len(stack)-1]
+		if top.object {
+			top.wantKey = true
+		} else {
+			top.index++
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(content))
+	var stack []frame
+	for {
+		start := skipSeparators(content, int(dec.InputOffset()))
+		token, err := dec.Token()
+		if err != nil {
+			return 0, 0, false
+		}
+
+		if len(stack) > 0 && stack[len(stack)-1].object && stack[len(stack)-1].wantKey {
+			if key, ok := token.(string); ok {
+				stack[len(stack)-1].key = key
+				stack[len(stack)-1].wantKey = false
+				continue
+			}
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			advance(stack)
+			continue
+		}
+
+		if currentPointer(stack) == pointer {
+			line, column = lineAndColumn(content, start)
+			return line, column, true
+		}
+
+		switch token {
+		case json.Delim('{'):
+			stack = append(stack, frame{object: true, wantKey: true})
+		case json.Delim('['):
+			stack = append(stack, frame{})
+		default:
+			advance(stack)
+		}
+	}
+}
+
+// skipSeparators returns the offset of the next token after any whitespace, colons or commas.
+func skipSeparators(content []byte, offset int) int {
+	for offset < len(content) {
+		switch content[offset] {
+		case ' ', '\t', '\n', '\r', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// lineAndColumn returns the 1-based line and column of the byte offset.
+func lineAndColumn(content []byte, offset int) (int, int) {
+	prefix := content[:offset]
+	line := bytes.Count(prefix, []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(prefix, '\n')
+	return line, column
+}
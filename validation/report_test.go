@@ -0,0 +1,169 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testReportContent = []byte(`{
+  "catalog": {
+    "uuid": "not-a-uuid",
+    "controls": [
+      {"id": "ac-1"},
+      {"id": "ac-1"}
+    ]
+  }
+}`)
+
+func testReport() Report {
+	err := errors.Join(
+		newFindingsError("schema", "catalog", []Finding{
+			{RuleID: "pattern", Location: "/catalog/uuid", Value: "not-a-uuid", Message: "does not match pattern"},
+		}),
+		newFindingsError("constraints", "catalog", []Finding{
+			{RuleID: "unique-catalog-control-id", Location: "/catalog/controls/1/id", Value: "ac-1", Message: "value \"ac-1\" is not unique", Severity: SeverityWarning},
+		}),
+	)
+	return NewReport("catalog.json", testReportContent, []string{"schema", "uuid", "constraints"}, err)
+}
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		pointer    string
+		wantLine   int
+		wantColumn int
+		wantFound  bool
+	}{
+		{pointer: "", wantLine: 1, wantColumn: 1, wantFound: true},
+		{pointer: "/catalog", wantLine: 2, wantColumn: 14, wantFound: true},
+		{pointer: "/catalog/uuid", wantLine: 3, wantColumn: 13, wantFound: true},
+		{pointer: "/catalog/controls/0", wantLine: 5, wantColumn: 7, wantFound: true},
+		{pointer: "/catalog/controls/1/id", wantLine: 6, wantColumn: 14, wantFound: true},
+		{pointer: "/catalog/controls/2"},
+		{pointer: "/catalog/missing"},
+	}
+	for _, c := range tests {
+		t.Run(c.pointer, func(t *testing.T) {
+			line, column, found := locate(testReportContent, c.pointer)
+			require.Equal(t, c.wantFound, found)
+			require.Equal(t, c.wantLine, line)
+			require.Equal(t, c.wantColumn, column)
+		})
+	}
+}
+
+func TestEncodeSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeSARIF(&buf, testReport()))
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	require.Equal(t, toolName, run.Tool.Driver.Name)
+	var ruleIDs []string
+	for _, rule := range run.Tool.Driver.Rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+	require.Equal(t, []string{"schema", "uuid", "constraints"}, ruleIDs)
+
+	require.Len(t, run.Results, 2)
+	schemaResult := run.Results[0]
+	require.Equal(t, "schema", schemaResult.RuleID)
+	require.Equal(t, 0, schemaResult.RuleIndex)
+	require.Equal(t, "error", schemaResult.Level)
+	require.Equal(t, "pattern: /catalog/uuid: does not match pattern", schemaResult.Message.Text)
+	require.Equal(t, map[string]string{"ruleId": "pattern", "value": "not-a-uuid"}, schemaResult.Properties)
+	require.Len(t, schemaResult.Locations, 1)
+	require.Equal(t, "catalog.json", schemaResult.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, &sarifRegion{StartLine: 3, StartColumn: 13}, schemaResult.Locations[0].PhysicalLocation.Region)
+	require.Equal(t, "/catalog/uuid", schemaResult.Locations[0].LogicalLocations[0].FullyQualifiedName)
+
+	constraintResult := run.Results[1]
+	require.Equal(t, "constraints", constraintResult.RuleID)
+	require.Equal(t, 2, constraintResult.RuleIndex)
+	require.Equal(t, "warning", constraintResult.Level)
+	require.Equal(t, &sarifRegion{StartLine: 6, StartColumn: 14}, constraintResult.Locations[0].PhysicalLocation.Region)
+}
+
+func TestEncodeSARIF_NoContent(t *testing.T) {
+	report := NewReport("", nil, nil, errors.New("test error"))
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeSARIF(&buf, report))
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 1)
+	require.Equal(t, defaultValidatorType, log.Runs[0].Tool.Driver.Rules[0].ID)
+	require.Len(t, log.Runs[0].Results, 1)
+	require.Equal(t, ": test error", log.Runs[0].Results[0].Message.Text)
+	require.Empty(t, log.Runs[0].Results[0].Locations)
+}
+
+func TestEncodeJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeJUnit(&buf, testReport()))
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte(xml.Header)))
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	require.Equal(t, "catalog.json", suites.Name)
+	require.Equal(t, 3, suites.Tests)
+	require.Equal(t, 1, suites.Failures)
+	require.Len(t, suites.TestSuites, 3)
+
+	schemaSuite := suites.TestSuites[0]
+	require.Equal(t, "schema", schemaSuite.Name)
+	require.Equal(t, 1, schemaSuite.Failures)
+	require.Len(t, schemaSuite.TestCases, 1)
+	require.Equal(t, "pattern /catalog/uuid", schemaSuite.TestCases[0].Name)
+	require.NotNil(t, schemaSuite.TestCases[0].Failure)
+	require.Equal(t, "does not match pattern", schemaSuite.TestCases[0].Failure.Message)
+	require.Equal(t, "error", schemaSuite.TestCases[0].Failure.Type)
+	require.Equal(t, "catalog.json:3:13: does not match pattern", schemaSuite.TestCases[0].Failure.Text)
+
+	uuidSuite := suites.TestSuites[1]
+	require.Equal(t, "uuid", uuidSuite.Name)
+	require.Equal(t, 0, uuidSuite.Failures)
+	require.Len(t, uuidSuite.TestCases, 1)
+	require.Nil(t, uuidSuite.TestCases[0].Failure)
+
+	constraintSuite := suites.TestSuites[2]
+	require.Equal(t, "constraints", constraintSuite.Name)
+	require.Equal(t, 0, constraintSuite.Failures)
+	require.Nil(t, constraintSuite.TestCases[0].Failure)
+	require.Equal(t, "warning: catalog.json:6:14: value \"ac-1\" is not unique", constraintSuite.TestCases[0].SystemOut)
+}
+
+func TestEncode_NoLocation(t *testing.T) {
+	err := newFindingsError("schema", "catalog", []Finding{{RuleID: "required", Message: "missing metadata"}})
+	report := NewReport("catalog.json", testReportContent, []string{"schema"}, err)
+
+	var sarif bytes.Buffer
+	require.NoError(t, EncodeSARIF(&sarif, report))
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(sarif.Bytes(), &log))
+	require.Len(t, log.Runs[0].Results, 1)
+	location := log.Runs[0].Results[0].Locations[0]
+	require.Equal(t, "catalog.json", location.PhysicalLocation.ArtifactLocation.URI)
+	require.Nil(t, location.PhysicalLocation.Region)
+
+	var junit bytes.Buffer
+	require.NoError(t, EncodeJUnit(&junit, report))
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(junit.Bytes(), &suites))
+	require.Equal(t, "missing metadata", suites.TestSuites[0].TestCases[0].Failure.Text)
+}
@@ -0,0 +1,259 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+var _ Validator = (*RuleValidator)(nil)
+
+const ruleValidatorType = "rules"
+
+// Rule IDs of the findings reported by the RuleValidator.
+const (
+	// CheckWithoutRuleFinding is reported for a Check_Id property without a Rule_Id
+	// property in the same rule set.
+	CheckWithoutRuleFinding = "check-without-rule"
+	// ParameterWithoutIDFinding is reported for a Parameter_* property without a
+	// Parameter_Id property with the same suffix in the same rule set.
+	ParameterWithoutIDFinding = "parameter-without-id"
+	// ConflictingRuleDescriptionFinding is reported for a rule defined more than once
+	// with different descriptions.
+	ConflictingRuleDescriptionFinding = "conflicting-rule-description"
+	// UndefinedRuleFinding is reported for a Rule_Id property on an implemented requirement
+	// for a rule that is not defined on any component.
+	UndefinedRuleFinding = "undefined-rule"
+	// MissingValidationComponentFinding is reported for a rule defined on a component
+	// that no validation component implements with a check.
+	MissingValidationComponentFinding = "missing-validation-component"
+)
+
+// RuleValidator implements the Validator interface to check the trestle rule, check and
+// parameter property conventions in component definitions. Other models are not checked.
+type RuleValidator struct{}
+
+func (r RuleValidator) Validate(model oscalTypes.OscalModels) error {
+	if model.ComponentDefinition == nil || model.ComponentDefinition.Components == nil {
+		return nil
+	}
+
+	definedRules := make(map[string]ruleDefinition)
+	var findings []Finding
+	for i, component := range *model.ComponentDefinition.Components {
+		if component.Props == nil {
+			continue
+		}
+		pointer := fmt.Sprintf("/component-definition/components/%d/props", i)
+		for _, group := range groupRuleSetProps(*component.Props) {
+			findings = append(findings, lintRuleSet(component, pointer, group, definedRules)...)
+		}
+	}
+	findings = append(findings, unimplementedRules(definedRules)...)
+
+	for i, component := range *model.ComponentDefinition.Components {
+		if component.ControlImplementations == nil {
+			continue
+		}
+		for j, implementation := range *component.ControlImplementations {
+			for k, requirement := range implementation.ImplementedRequirements {
+				pointer := fmt.Sprintf("/component-definition/components/%d/control-implementations/%d/implemented-requirements/%d", i, j, k)
+				findings = append(findings, undefinedRules(requirement.Props, pointer+"/props", definedRules)...)
+				if requirement.Statements == nil {
+					continue
+				}
+				for s, statement := range *requirement.Statements {
+					findings = append(findings, undefinedRules(statement.Props, fmt.Sprintf("%s/statements/%d/props", pointer, s), definedRules)...)
+				}
+			}
+		}
+	}
+
+	if len(findings) > 0 {
+		return newFindingsError(ruleValidatorType, "component-definition", findings)
+	}
+	return nil
+}
+
+// ruleDefinition is the first definition of a rule with a description.
+type ruleDefinition struct {
+	description string
+	pointer     string
+	// rulePointer is the location of the first Rule_Id of the rule on a component
+	// that is not a validation component.
+	rulePointer string
+	// implemented is whether a validation component implements the rule with a check.
+	implemented bool
+}
+
+// indexedProp is a property and its index in the props of a component.
+type indexedProp struct {
+	prop  oscalTypes.Property
+	index int
+}
+
+// groupRuleSetProps returns the trestle properties of a component grouped by remarks in
+// remarks order.
+func groupRuleSetProps(props []oscalTypes.Property) [][]indexedProp {
+	byRemarks := make(map[string][]indexedProp)
+	for i, prop := range props {
+		if prop.Remarks == "" || !strings.Contains(prop.Ns, extensions.TrestleNameSpace) {
+			continue
+		}
+		byRemarks[prop.Remarks] = append(byRemarks[prop.Remarks], indexedProp{prop: prop, index: i})
+	}
+	remarks := make([]string, 0, len(byRemarks))
+	for key := range byRemarks {
+		remarks = append(remarks, key)
+	}
+	sort.Strings(remarks)
+	groups := make([][]indexedProp, 0, len(remarks))
+	for _, key := range remarks {
+		groups = append(groups, byRemarks[key])
+	}
+	return groups
+}
+
+// lintRuleSet returns the findings for a rule set property group of a component and
+// records the rule definitions of the group.
+func lintRuleSet(component oscalTypes.DefinedComponent, pointer string, group []indexedProp, definedRules map[string]ruleDefinition) []Finding {
+	var findings []Finding
+	location := func(p indexedProp) string { return fmt.Sprintf("%s/%d", pointer, p.index) }
+
+	var ruleID, description *indexedProp
+	var checks []indexedProp
+	parameterIDs := make(map[string]bool)
+	var parameterProps []indexedProp
+	for i := range group {
+		p := group[i]
+		switch p.prop.Name {
+		case extensions.RuleIdProp:
+			ruleID = &group[i]
+		case extensions.RuleDescriptionProp:
+			description = &group[i]
+		case extensions.CheckIdProp:
+			checks = append(checks, p)
+		default:
+			name, suffix := parameterPropName(p.prop.Name)
+			if name == extensions.ParameterIdProp {
+				parameterIDs[suffix] = true
+			} else if strings.HasPrefix(name, "Parameter_") {
+				parameterProps = append(parameterProps, p)
+			}
+		}
+	}
+
+	for _, check := range checks {
+		if ruleID == nil {
+			findings = append(findings, Finding{
+				RuleID:   CheckWithoutRuleFinding,
+				Location: location(check),
+				Value:    check.prop.Value,
+				Message:  fmt.Sprintf("check %q has no Rule_Id in rule set %q", check.prop.Value, check.prop.Remarks),
+			})
+		}
+	}
+
+	for _, parameter := range parameterProps {
+		if _, suffix := parameterPropName(parameter.prop.Name); !parameterIDs[suffix] {
+			findings = append(findings, Finding{
+				RuleID:   ParameterWithoutIDFinding,
+				Location: location(parameter),
+				Value:    parameter.prop.Value,
+				Message:  fmt.Sprintf("%s has no matching Parameter_Id in rule set %q", parameter.prop.Name, parameter.prop.Remarks),
+			})
+		}
+	}
+
+	if ruleID == nil {
+		return findings
+	}
+	defined := definedRules[ruleID.prop.Value]
+	if component.Type == extensions.ValidationComponentType {
+		defined.implemented = defined.implemented || len(checks) > 0
+	} else if defined.rulePointer == "" {
+		defined.rulePointer = location(*ruleID)
+	}
+	switch {
+	case description == nil:
+	case defined.description == "":
+		defined.description = description.prop.Value
+		defined.pointer = location(*description)
+	case description.prop.Value != defined.description:
+		findings = append(findings, Finding{
+			RuleID:   ConflictingRuleDescriptionFinding,
+			Location: location(*description),
+			Value:    description.prop.Value,
+			Message:  fmt.Sprintf("rule %q has a different description at %s", ruleID.prop.Value, defined.pointer),
+		})
+	}
+	definedRules[ruleID.prop.Value] = defined
+	return findings
+}
+
+// unimplementedRules returns the findings for rules defined on components that no validation
+// component implements, in rule ID order.
+func unimplementedRules(definedRules map[string]ruleDefinition) []Finding {
+	ruleIDs := make([]string, 0, len(definedRules))
+	for ruleID := range definedRules {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+
+	var findings []Finding
+	for _, ruleID := range ruleIDs {
+		defined := definedRules[ruleID]
+		if defined.rulePointer == "" || defined.implemented {
+			continue
+		}
+		findings = append(findings, Finding{
+			RuleID:   MissingValidationComponentFinding,
+			Location: defined.rulePointer,
+			Value:    ruleID,
+			Message:  fmt.Sprintf("rule %q is not implemented by any validation component", ruleID),
+		})
+	}
+	return findings
+}
+
+// undefinedRules returns the findings for Rule_Id properties of an implemented requirement or
+// statement for rules that are not defined on any component.
+func undefinedRules(props *[]oscalTypes.Property, pointer string, definedRules map[string]ruleDefinition) []Finding {
+	if props == nil {
+		return nil
+	}
+	var findings []Finding
+	for i, prop := range *props {
+		if prop.Name != extensions.RuleIdProp || !strings.Contains(prop.Ns, extensions.TrestleNameSpace) {
+			continue
+		}
+		if _, ok := definedRules[prop.Value]; !ok {
+			findings = append(findings, Finding{
+				RuleID:   UndefinedRuleFinding,
+				Location: fmt.Sprintf("%s/%d", pointer, i),
+				Value:    prop.Value,
+				Message:  fmt.Sprintf("rule %q is not defined on any component", prop.Value),
+			})
+		}
+	}
+	return findings
+}
+
+// parameterPropName returns the parameter property name without the numerical suffix and
+// the suffix, e.g. "Parameter_Id" and "1" for Parameter_Id_1.
+func parameterPropName(name string) (string, string) {
+	if !extensions.ParameterSuffixRegex.MatchString(name) {
+		return name, ""
+	}
+	i := strings.LastIndex(name, "_")
+	return name[:i], name[i+1:]
+}
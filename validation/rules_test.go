@@ -0,0 +1,206 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+func TestRuleValidator(t *testing.T) {
+	ruleProp := func(name, value, remarks string) oscalTypes.Property {
+		return oscalTypes.Property{Name: name, Value: value, Ns: extensions.TrestleNameSpace, Remarks: remarks}
+	}
+	componentDefinition := func(comps ...oscalTypes.DefinedComponent) oscalTypes.OscalModels {
+		return oscalTypes.OscalModels{
+			ComponentDefinition: &oscalTypes.ComponentDefinition{UUID: "definition", Components: &comps},
+		}
+	}
+	service := oscalTypes.DefinedComponent{
+		UUID: "service", Title: "Kubernetes", Type: "service",
+		Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+			ruleProp(extensions.RuleDescriptionProp, "Ensure the key file is set", "rule_set_0"),
+			ruleProp(extensions.ParameterIdProp+"_1", "file_name", "rule_set_0"),
+			ruleProp(extensions.ParameterDescriptionProp+"_1", "The file name", "rule_set_0"),
+		},
+		ControlImplementations: &[]oscalTypes.ControlImplementationSet{
+			{
+				ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+					{
+						ControlId: "ac-1",
+						Props: &[]oscalTypes.Property{
+							{Name: extensions.RuleIdProp, Value: "etcd_key_file", Ns: extensions.TrestleNameSpace},
+						},
+					},
+				},
+			},
+		},
+	}
+	validator := oscalTypes.DefinedComponent{
+		UUID: "validator", Title: "Validator", Type: "validation",
+		Props: &[]oscalTypes.Property{
+			ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+			ruleProp(extensions.CheckIdProp, "etcd_key_file_check", "rule_set_0"),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		model        oscalTypes.OscalModels
+		wantFindings []Finding
+	}{
+		{
+			name:  "Valid/ComponentDefinition",
+			model: componentDefinition(service, validator),
+		},
+		{
+			name:  "Valid/OtherModel",
+			model: oscalTypes.OscalModels{Catalog: &oscalTypes.Catalog{UUID: "catalog"}},
+		},
+		{
+			name: "Invalid/CheckWithoutRule",
+			model: componentDefinition(service, oscalTypes.DefinedComponent{
+				UUID: "validator", Title: "Validator", Type: "validation",
+				Props: &[]oscalTypes.Property{
+					ruleProp(extensions.CheckIdProp, "etcd_key_file_check", "rule_set_0"),
+					ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_1"),
+					ruleProp(extensions.CheckIdProp, "etcd_key_file_scan", "rule_set_1"),
+				},
+			}),
+			wantFindings: []Finding{
+				{
+					Validator: "rules",
+					Severity:  SeverityError,
+					RuleID:    CheckWithoutRuleFinding,
+					Location:  "/component-definition/components/1/props/0",
+					Value:     "etcd_key_file_check",
+					Message:   `check "etcd_key_file_check" has no Rule_Id in rule set "rule_set_0"`,
+				},
+			},
+		},
+		{
+			name: "Invalid/ParameterWithoutID",
+			model: componentDefinition(oscalTypes.DefinedComponent{
+				UUID: "service", Title: "Kubernetes", Type: "service",
+				Props: &[]oscalTypes.Property{
+					ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+					ruleProp(extensions.ParameterIdProp+"_1", "file_name", "rule_set_0"),
+					ruleProp(extensions.ParameterDescriptionProp+"_2", "The file mode", "rule_set_0"),
+				},
+			}, validator),
+			wantFindings: []Finding{
+				{
+					Validator: "rules",
+					Severity:  SeverityError,
+					RuleID:    ParameterWithoutIDFinding,
+					Location:  "/component-definition/components/0/props/2",
+					Value:     "The file mode",
+					Message:   `Parameter_Description_2 has no matching Parameter_Id in rule set "rule_set_0"`,
+				},
+			},
+		},
+		{
+			name: "Invalid/ConflictingRuleDescription",
+			model: componentDefinition(service, oscalTypes.DefinedComponent{
+				UUID: "openshift", Title: "OpenShift", Type: "service",
+				Props: &[]oscalTypes.Property{
+					ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+					ruleProp(extensions.RuleDescriptionProp, "Ensure the cert file is set", "rule_set_0"),
+				},
+			}, validator),
+			wantFindings: []Finding{
+				{
+					Validator: "rules",
+					Severity:  SeverityError,
+					RuleID:    ConflictingRuleDescriptionFinding,
+					Location:  "/component-definition/components/1/props/1",
+					Value:     "Ensure the cert file is set",
+					Message:   `rule "etcd_key_file" has a different description at /component-definition/components/0/props/1`,
+				},
+			},
+		},
+		{
+			name: "Invalid/UndefinedRule",
+			model: componentDefinition(oscalTypes.DefinedComponent{
+				UUID: "service", Title: "Kubernetes", Type: "service",
+				ControlImplementations: service.ControlImplementations,
+			}),
+			wantFindings: []Finding{
+				{
+					Validator: "rules",
+					Severity:  SeverityError,
+					RuleID:    UndefinedRuleFinding,
+					Location:  "/component-definition/components/0/control-implementations/0/implemented-requirements/0/props/0",
+					Value:     "etcd_key_file",
+					Message:   `rule "etcd_key_file" is not defined on any component`,
+				},
+			},
+		},
+		{
+			name: "Invalid/MissingValidationComponent",
+			model: componentDefinition(service, oscalTypes.DefinedComponent{
+				UUID: "openshift", Title: "OpenShift", Type: "service",
+				Props: &[]oscalTypes.Property{
+					ruleProp(extensions.RuleIdProp, "etcd_cert_file", "rule_set_0"),
+					// Checks on other components do not implement the rule.
+					ruleProp(extensions.CheckIdProp, "etcd_cert_file_check", "rule_set_0"),
+				},
+			}, oscalTypes.DefinedComponent{
+				UUID: "validator", Title: "Validator", Type: "validation",
+				Props: &[]oscalTypes.Property{
+					ruleProp(extensions.RuleIdProp, "etcd_key_file", "rule_set_0"),
+					ruleProp(extensions.CheckIdProp, "etcd_key_file_check", "rule_set_0"),
+					// A rule without a check is not implemented.
+					ruleProp(extensions.RuleIdProp, "etcd_cert_file", "rule_set_1"),
+				},
+			}),
+			wantFindings: []Finding{
+				{
+					Validator: "rules",
+					Severity:  SeverityError,
+					RuleID:    MissingValidationComponentFinding,
+					Location:  "/component-definition/components/1/props/0",
+					Value:     "etcd_cert_file",
+					Message:   `rule "etcd_cert_file" is not implemented by any validation component`,
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			err := RuleValidator{}.Validate(c.model)
+			if len(c.wantFindings) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, c.wantFindings, FindingsFromError(err))
+		})
+	}
+}
+
+func TestRuleValidator_TestData(t *testing.T) {
+	for _, testDataPath := range []string{
+		"../testdata/component-definition-test.json",
+		"../testdata/component-definition-test-multi-param.json",
+		"../testdata/component-definition-target-components.json",
+	} {
+		t.Run(testDataPath, func(t *testing.T) {
+			content, err := os.ReadFile(testDataPath)
+			require.NoError(t, err)
+			var model oscalTypes.OscalModels
+			require.NoError(t, json.Unmarshal(content, &model))
+			require.NoError(t, RuleValidator{}.Validate(model))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Below are the subset of SARIF 2.1.0 types used to export validation findings.
+// Reference: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	RuleIndex  int               `json:"ruleIndex"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// EncodeSARIF writes the Report as a SARIF 2.1.0 log. Each validator is a rule of the
+// tool and each finding is a result located at the finding JSON pointer.
+func EncodeSARIF(w io.Writer, report Report) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           toolName,
+				InformationURI: toolInformationURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	for ruleIndex, validatorType := range report.validatorTypes() {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               validatorType,
+			ShortDescription: sarifMessage{Text: fmt.Sprintf("OSCAL %s validation", validatorType)},
+		})
+		for _, finding := range report.findingsByValidator(validatorType) {
+			run.Results = append(run.Results, sarifResultFromFinding(report, finding, validatorType, ruleIndex))
+		}
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func sarifResultFromFinding(report Report, finding Finding, validatorType string, ruleIndex int) sarifResult {
+	result := sarifResult{
+		RuleID:    validatorType,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(finding.Severity),
+		Message:   sarifMessage{Text: finding.Error()},
+	}
+
+	properties := make(map[string]string)
+	if finding.RuleID != "" {
+		properties["ruleId"] = finding.RuleID
+	}
+	if finding.Value != "" {
+		properties["value"] = finding.Value
+	}
+	if len(properties) > 0 {
+		result.Properties = properties
+	}
+
+	var location sarifLocation
+	if report.DocumentURI != "" {
+		location.PhysicalLocation = &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: report.DocumentURI},
+		}
+		if line, column, found := report.position(finding); found {
+			location.PhysicalLocation.Region = &sarifRegion{StartLine: line, StartColumn: column}
+		}
+	}
+	if finding.Location != "" {
+		location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: finding.Location}}
+	}
+	if location.PhysicalLocation != nil || location.LogicalLocations != nil {
+		result.Locations = []sarifLocation{location}
+	}
+	return result
+}
+
+func sarifLevel(severity Severity) string {
+	if severity == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
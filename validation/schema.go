@@ -6,6 +6,8 @@
 package validation
 
 import (
+	"fmt"
+
 	oscalValidation "github.com/defenseunicorns/go-oscal/src/pkg/validation"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 )
@@ -47,7 +49,25 @@ func (s *SchemaValidator) Validate(modelData oscalTypes.OscalModels) error {
 	modelType := validator.GetModelType()
 	err = validator.Validate()
 	if err != nil {
-		return &ValidationError{Type: s.id, Model: modelType, Err: err}
+		validationErr := &ValidationError{Type: s.id, Model: modelType, Err: err}
+		result, resultErr := validator.GetValidationResult()
+		if resultErr != nil {
+			return validationErr
+		}
+		for _, schemaErr := range result.Errors {
+			finding := Finding{
+				Validator: s.id,
+				Severity:  SeverityError,
+				RuleID:    schemaErr.KeywordLocation,
+				Location:  schemaErr.InstanceLocation,
+				Message:   schemaErr.Error,
+			}
+			if schemaErr.FailedValue != nil {
+				finding.Value = fmt.Sprintf("%v", schemaErr.FailedValue)
+			}
+			validationErr.Findings = append(validationErr.Findings, finding)
+		}
+		return validationErr
 	}
 
 	return nil
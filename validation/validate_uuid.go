@@ -4,22 +4,53 @@ import (
 	"fmt"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
-
-	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
 )
 
+var _ Validator = (*UuidValidator)(nil)
+
+const uuidValidatorType = "uuid"
+
 // UuidValidator implements the Validator interface to check for duplicate UUIDs and ParamIds in OSCAL models.
 // It ensures uniqueness of identifiers across the model structure.
 type UuidValidator struct{}
 
 func (d UuidValidator) Validate(model oscalTypes.OscalModels) error {
-	if modelutils.HasDuplicateValuesByName(&model, "UUID") {
-		return fmt.Errorf("duplicate UUIDs found")
+	document, err := toDocument(model)
+	if err != nil {
+		return &ValidationError{Type: uuidValidatorType, Model: "", Err: err}
 	}
+
+	findings := duplicateValues(document, "**/uuid", "duplicate-uuid")
 	if model.Profile != nil {
-		if modelutils.HasDuplicateValuesByName(&model, "ParamId") {
-			return fmt.Errorf("duplicate ParamIds found")
-		}
+		findings = append(findings, duplicateValues(document, "**/param-id", "duplicate-param-id")...)
+	}
+	if len(findings) > 0 {
+		return newFindingsError(uuidValidatorType, modelTypeOf(document), findings)
 	}
 	return nil
 }
+
+// duplicateValues returns a Finding for each string value at the target that
+// has already been seen in the document.
+func duplicateValues(document interface{}, target, ruleID string) []Finding {
+	var findings []Finding
+	seen := make(map[string]string)
+	for _, n := range selectNodes(document, target) {
+		value, ok := n.value.(string)
+		if !ok {
+			continue
+		}
+		first, dup := seen[value]
+		if !dup {
+			seen[value] = n.pointer
+			continue
+		}
+		findings = append(findings, Finding{
+			RuleID:   ruleID,
+			Location: n.pointer,
+			Value:    value,
+			Message:  fmt.Sprintf("value %q is already defined at %s", value, first),
+		})
+	}
+	return findings
+}
@@ -69,6 +69,24 @@ func TestValidateUUID(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "component definition with properties without UUIDs",
+			model: oscalTypes.OscalModels{
+				ComponentDefinition: &oscalTypes.ComponentDefinition{
+					UUID: "uuid-1",
+					Components: &[]oscalTypes.DefinedComponent{
+						{
+							UUID: "uuid-2",
+							Props: &[]oscalTypes.Property{
+								{Name: "prop-1", Value: "value-1"},
+								{Name: "prop-2", Value: "value-2"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	validator := UuidValidator{}
 	for _, tt := range tests {
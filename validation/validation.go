@@ -30,6 +30,9 @@ type ValidationError struct {
 	Model string
 	// Err return the error message.
 	Err error
+	// Findings returns the individual violations that caused the error, if
+	// reported by the validator.
+	Findings []Finding
 }
 
 func (e *ValidationError) Error() string {
@@ -53,6 +56,8 @@ func (f ValidatorFunc) Validate(models oscalTypes.OscalModels) error {
 }
 
 // ValidateAll returns a func that will run multiple validators in sequence.
+// The errors from all validators are joined and the combined findings can be
+// retrieved with FindingsFromError.
 func ValidateAll(validators ...Validator) ValidatorFunc {
 	return func(models oscalTypes.OscalModels) error {
 		var valErrors []error